@@ -0,0 +1,88 @@
+package resultgroup
+
+import (
+	"fmt"
+	"time"
+)
+
+// adaptiveLimit holds the state of the limit set with SetAdaptiveLimit.
+type adaptiveLimit struct {
+	min, max float64
+	latency  time.Duration
+	limit    float64
+}
+
+// SetAdaptiveLimit limits the number of tasks running at the same time like
+// SetLimit, but adjusts the limit between min and max as tasks return, so
+// the group backs off from a struggling downstream service without hand
+// tuning. The limit starts at max. Each task that fails, or that runs for
+// longer than latency if latency is greater than 0, halves the limit; each
+// task that succeeds grows it additively, by one after about a limit's worth
+// of successful tasks. Errors ignored by the error policy count as
+// successes.
+// Tasks running when the limit shrinks are not interrupted: new tasks wait
+// until the number of running tasks is below the new limit.
+// Min must be at least 1 and not greater than max. Like SetLimit,
+// SetAdaptiveLimit panics if any tasks are still running, and SetLimit
+// replaces the adaptive limit.
+func (g *Group[T]) SetAdaptiveLimit(min, max int, latency time.Duration) {
+	g.checkNil()
+
+	if min < 1 || min > max {
+		panic("adaptive limit must satisfy 1 <= min <= max")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.inFlight != 0 {
+		panic(fmt.Errorf("resultgroup: modify limit while %v tasks are still running", g.inFlight))
+	}
+
+	g.sem = newSemaphore(int64(max))
+	g.adaptive = &adaptiveLimit{min: float64(min), max: float64(max), latency: latency, limit: float64(max)}
+}
+
+// adapt adjusts the adaptive limit, if any, to the outcome of task t.
+// It must be called with the mutex held.
+func (g *Group[T]) adapt(t *task[T], err error) {
+	a := g.adaptive
+	if a == nil {
+		return
+	}
+
+	prev := int64(a.limit)
+	if err != nil || a.latency > 0 && t.duration > a.latency {
+		a.limit /= 2
+		if a.limit < a.min {
+			a.limit = a.min
+		}
+	} else {
+		a.limit += 1 / a.limit
+		if a.limit > a.max {
+			a.limit = a.max
+		}
+	}
+
+	if n := int64(a.limit); n != prev {
+		g.sem.resize(n)
+	}
+}
+
+// Limit returns the current limit on the number of running tasks, which
+// changes over time with SetAdaptiveLimit, or -1 without a limit.
+func (g *Group[T]) Limit() int {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.sem == nil {
+		return -1
+	}
+
+	g.sem.mu.Lock()
+	defer g.sem.mu.Unlock()
+
+	return int(g.sem.size)
+}
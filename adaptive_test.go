@@ -0,0 +1,37 @@
+package resultgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAdaptiveLimit checks that failures shrink the limit down to the minimum and successes grow it back.
+func TestAdaptiveLimit(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetAdaptiveLimit(2, 8, 0)
+
+	assert.Equal(t, 8, group.Limit(), "Expected the limit to start at the maximum, got: %v", group.Limit())
+
+	for i := 0; i < 3; i++ {
+		group.Go(func() ([]int, error) {
+			return nil, err1
+		})
+		_, _ = group.WaitTask(i)
+	}
+
+	assert.Equal(t, 2, group.Limit(), "Expected failures to shrink the limit to the minimum, got: %v", group.Limit())
+
+	for i := 3; i < 20; i++ {
+		group.Go(func() ([]int, error) {
+			return []int{1}, nil
+		})
+		_, _ = group.WaitTask(i)
+	}
+
+	assert.Greater(t, group.Limit(), 4, "Expected successes to grow the limit, got: %v", group.Limit())
+
+	_, err := group.Wait()
+	assert.Error(t, err, "Expected the errors of the failed tasks")
+}
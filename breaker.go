@@ -0,0 +1,42 @@
+package resultgroup
+
+// SetCircuitBreaker makes the group fail fast once n tasks in a row have
+// failed, when a downstream dependency is clearly down: the tasks that have
+// not started yet, including the ones submitted later, are not run, and
+// WaitTask and WaitDetailed report ErrCircuitOpen for them. ErrCircuitOpen is
+// then joined once with the errors returned by Wait, and does not count
+// toward the threshold. Unlike the threshold,
+// the breaker does not cancel the group context, so running tasks finish as
+// usual. Errors ignored by the error policy do not count as failures.
+// The breaker stays open until Reset. A value of 0, the default, disables
+// the breaker.
+func (g *Group[T]) SetCircuitBreaker(n int) {
+	g.checkNil()
+
+	if n < 0 {
+		panic("circuit breaker failures must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.breaker = n
+}
+
+// trackFailures counts the consecutive failures, and opens the circuit once
+// the breaker trips. It must be called with the mutex held.
+func (g *Group[T]) trackFailures(err error) {
+	if g.breaker == 0 || g.circuitOpen {
+		return
+	}
+
+	if err == nil {
+		g.consecutive = 0
+		return
+	}
+
+	g.consecutive++
+	if g.consecutive >= g.breaker {
+		g.circuitOpen = true
+	}
+}
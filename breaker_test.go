@@ -0,0 +1,54 @@
+package resultgroup
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCircuitBreaker checks that the tasks submitted once the breaker trips are not run and fail with ErrCircuitOpen.
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+	group.SetCircuitBreaker(2)
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		group.Go(func() ([]int, error) {
+			return nil, err2
+		})
+	}
+
+	var ran atomic.Bool
+	group.Go(func() ([]int, error) {
+		ran.Store(true)
+		return []int{2}, nil
+	})
+
+	_, err := group.WaitTask(4)
+	assert.ErrorIs(t, err, ErrCircuitOpen, "Expected error to be: %v, got: %v", ErrCircuitOpen, err)
+
+	results, err := group.Wait()
+
+	assert.False(t, ran.Load(), "Expected the task not to run once the circuit is open")
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.Equal(t, []error{err1, err2, err2, ErrCircuitOpen}, unwrapErrors(err), "Expected errors, got: %v", err)
+
+	group.Reset()
+	group.Go(func() ([]int, error) {
+		return []int{3}, nil
+	})
+
+	results, err = group.Wait()
+	assert.NoError(t, err, "Expected Reset to close the circuit, got: %v", err)
+	assert.Equal(t, []int{3}, results, "Expected results to be: %v, got: %v", []int{3}, results)
+}
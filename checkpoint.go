@@ -0,0 +1,103 @@
+package resultgroup
+
+import "time"
+
+// SetCheckpoint makes the group call save with a copy of the results collected
+// so far once every interval, until Wait is called. It lets long batches
+// persist their progress, so an interrupted run can be resumed.
+// Errors returned by save do not affect the group; they are counted and
+// reported by CheckpointErrors.
+// SetCheckpoint must be called before any task is started.
+// Calling it again replaces the previous checkpoint.
+func (g *Group[T]) SetCheckpoint(interval time.Duration, save func([]T) error) {
+	g.checkNil()
+
+	if interval <= 0 {
+		panic("checkpoint interval must be greater than 0")
+	}
+
+	g.stopCheckpoint()
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	g.mutex.Lock()
+	g.checkpointStop = stop
+	g.checkpointDone = done
+	g.mutex.Unlock()
+
+	go g.runCheckpoint(interval, save, stop, done)
+}
+
+// CheckpointErrors returns the number of times the checkpoint save
+// function returned an error.
+func (g *Group[T]) CheckpointErrors() int {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return g.checkpointErrs
+}
+
+// Snapshot returns a copy of the results collected so far, and the error
+// Wait would return for the errors collected so far, without waiting for the
+// running tasks. It lets long batches persist partial progress or report
+// their status while tasks are in flight. Later tasks do not modify the
+// returned results and error.
+func (g *Group[T]) Snapshot() ([]T, error) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	results := append([]T(nil), g.collectedResults()...)
+	err := g.err()
+	if merr, ok := err.(*MultiError); ok {
+		merr.errs = append([]error(nil), merr.errs...)
+		merr.counts = append([]int(nil), merr.counts...)
+	}
+
+	return results, err
+}
+
+func (g *Group[T]) runCheckpoint(interval time.Duration, save func([]T) error, stop, done chan struct{}) {
+	defer close(done)
+
+	for {
+		timer := g.newTimer(interval)
+
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+
+		g.mutex.Lock()
+		results := append([]T(nil), g.collectedResults()...)
+		g.mutex.Unlock()
+
+		if err := save(results); err != nil {
+			g.mutex.Lock()
+			g.checkpointErrs++
+			g.mutex.Unlock()
+		}
+	}
+}
+
+// stopCheckpoint stops the running checkpoint, if any, and waits for the
+// save function to return.
+func (g *Group[T]) stopCheckpoint() {
+	g.mutex.Lock()
+	stop, done := g.checkpointStop, g.checkpointDone
+	g.checkpointStop, g.checkpointDone = nil, nil
+	g.mutex.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	close(stop)
+	<-done
+}
@@ -0,0 +1,81 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckpoint checks that the Group periodically saves the results collected so far.
+func TestCheckpoint(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	var (
+		mu    sync.Mutex
+		saved [][]int
+	)
+
+	group.SetCheckpoint(5*time.Millisecond, func(results []int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		saved = append(saved, results)
+
+		if len(saved) == 1 {
+			return err1
+		}
+
+		return nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(30 * time.Millisecond)
+		return []int{2}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, results, 2, "Expected 2 results, got: %d", len(results))
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.NotEmpty(t, saved, "Expected at least one checkpoint")
+	assert.Equal(t, 1, group.CheckpointErrors(), "Expected 1 checkpoint error, got: %d", group.CheckpointErrors())
+
+	for _, s := range saved {
+		assert.LessOrEqual(t, len(s), 2, "Expected at most 2 saved results, got: %d", len(s))
+	}
+}
+
+// TestSnapshot checks that Snapshot returns the results and errors collected so far while tasks are running.
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 3)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, err1
+	})
+	_, _ = group.WaitTask(0)
+
+	release := make(chan struct{})
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{2}, err2
+	})
+
+	results, err := group.Snapshot()
+	close(release)
+	_, _ = group.Wait()
+
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+}
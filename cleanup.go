@@ -0,0 +1,43 @@
+package resultgroup
+
+import "context"
+
+// GoWithCleanup works like Go, but calls cleanup once f is done with the
+// error of the task, or with the reason f was not run: the cause of the
+// group context if it is canceled while GoWithCleanup waits under the limit,
+// or the error reported for a task skipped because of SetCircuitBreaker or
+// SetSkipOnCancel. It lets resources acquired for a task, such as temporary
+// files or leases, be released even if the task is aborted before it starts.
+// Cleanup runs before Wait returns, unless Wait stops waiting for the task
+// because of SetCancelGrace or WithGracefulDeadline, or WaitContext or
+// WaitTimeout return early: cleanup is then called once the abandoned task
+// returns, as it may still be using its resources.
+func (g *Group[T]) GoWithCleanup(f func() ([]T, error), cleanup func(error)) {
+	g.checkNil()
+
+	if !g.acquire(1) {
+		cleanup(g.abortCause())
+		return
+	}
+
+	g.wg.Add(1)
+	t := g.addTask("")
+	t.cleanup = cleanup
+
+	g.schedule(func() {
+		defer g.done(1)
+
+		g.run(t, f)
+	})
+}
+
+// abortCause returns the reason a task could not be submitted: the cause
+// of the group context, or ErrTaskSkipped if the group was aborted without
+// a context.
+func (g *Group[T]) abortCause() error {
+	if err := context.Cause(g.context()); err != nil {
+		return err
+	}
+
+	return ErrTaskSkipped
+}
@@ -0,0 +1,96 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGoWithCleanup checks that the cleanup function is called for tasks that ran and tasks that were aborted.
+func TestGoWithCleanup(t *testing.T) {
+	t.Parallel()
+	t.Run("finished", testGoWithCleanupFinished)
+	t.Run("canceled while waiting", testGoWithCleanupCanceled)
+	t.Run("skipped", testGoWithCleanupSkipped)
+}
+
+// testGoWithCleanupFinished checks that cleanup gets the error of the task before Wait returns.
+func testGoWithCleanupFinished(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 2)
+
+	var (
+		mutex sync.Mutex
+		errs  []error
+	)
+	cleanup := func(err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		errs = append(errs, err)
+	}
+
+	group.GoWithCleanup(func() ([]int, error) {
+		return []int{1}, nil
+	}, cleanup)
+	group.GoWithCleanup(func() ([]int, error) {
+		return nil, err1
+	}, cleanup)
+
+	results, _ := group.Wait()
+
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.ElementsMatch(t, []error{nil, err1}, errs, "Expected cleanup errors to be: %v, got: %v", []error{nil, err1}, errs)
+}
+
+// testGoWithCleanupCanceled checks that cleanup gets the cause of the group context for a task that never acquired a slot.
+func testGoWithCleanupCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetLimit(1)
+
+	release := make(chan struct{})
+	group.Go(func() ([]int, error) {
+		<-release
+		return nil, nil
+	})
+	group.Cancel()
+
+	var got error
+	ran := false
+	group.GoWithCleanup(func() ([]int, error) {
+		ran = true
+		return nil, nil
+	}, func(err error) {
+		got = err
+	})
+	close(release)
+
+	_, _ = group.Wait()
+
+	assert.False(t, ran, "Expected the task not to run, got: %v", ran)
+	assert.ErrorIs(t, got, context.Canceled, "Expected cleanup error to be: %v, got: %v", context.Canceled, got)
+}
+
+// testGoWithCleanupSkipped checks that cleanup gets ErrTaskSkipped for a task skipped because the group is canceled.
+func testGoWithCleanupSkipped(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetSkipOnCancel(true)
+	group.Cancel()
+
+	var got error
+	ran := false
+	group.GoWithCleanup(func() ([]int, error) {
+		ran = true
+		return nil, nil
+	}, func(err error) {
+		got = err
+	})
+
+	_, _ = group.Wait()
+
+	assert.False(t, ran, "Expected the task not to run, got: %v", ran)
+	assert.ErrorIs(t, got, ErrTaskSkipped, "Expected cleanup error to be: %v, got: %v", ErrTaskSkipped, got)
+}
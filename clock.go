@@ -0,0 +1,186 @@
+package resultgroup
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Clock is the source of time of a Group, which can be replaced with
+// SetClock by a fake clock to test code built on the group without real
+// sleeps.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer creates a timer that fires once d has elapsed.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a timer created by a Clock, like time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the timer
+	// fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, and reports whether it did.
+	Stop() bool
+}
+
+// realClock is the Clock of the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) NewTimer(d time.Duration) Timer { return realTimer{time.NewTimer(d)} }
+
+// realTimer is a Timer backed by a time.Timer.
+type realTimer struct {
+	t *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time { return t.t.C }
+
+func (t realTimer) Stop() bool { return t.t.Stop() }
+
+// Scheduler starts the goroutines of the tasks of a Group, and can be
+// replaced with SetScheduler to control the order tasks start in, for
+// deterministic tests.
+type Scheduler interface {
+	// Schedule runs the given task, usually in a new goroutine. It is
+	// called by Go and the other methods that submit a task; a scheduler
+	// that calls run inline makes them return once the task is done.
+	Schedule(run func())
+}
+
+// SchedulerFunc is an adapter to use an ordinary function as a Scheduler.
+// SchedulerFunc(func(run func()) { run() }) runs every task synchronously,
+// in submission order.
+type SchedulerFunc func(run func())
+
+// Schedule calls f(run).
+func (f SchedulerFunc) Schedule(run func()) {
+	f(run)
+}
+
+// SetClock sets the clock used to time the tasks, for Stats, WaitDetailed
+// and the timeline, and to wait for the grace period, the retries of
+// GoWithRetry, the delays of GoHedged, the timeouts of SetTaskTimeout,
+// GoWithTimeout and WaitTimeout, and the checkpoint interval. Deadlines of
+// the contexts given to the group still follow the real time. A nil clock,
+// the default, uses the time package.
+func (g *Group[T]) SetClock(clock Clock) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.clock = clock
+}
+
+// SetScheduler sets the scheduler that starts the goroutines of the tasks.
+// A nil scheduler, the default, starts a new goroutine per task.
+func (g *Group[T]) SetScheduler(scheduler Scheduler) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.scheduler = scheduler
+}
+
+// clockLocked returns the clock of the group. It must be called with the
+// mutex held.
+func (g *Group[T]) clockLocked() Clock {
+	if g.clock == nil {
+		return realClock{}
+	}
+
+	return g.clock
+}
+
+// now returns the current time of the clock of the group.
+func (g *Group[T]) now() time.Time {
+	g.mutex.Lock()
+	clock := g.clockLocked()
+	g.mutex.Unlock()
+
+	return clock.Now()
+}
+
+// newTimer creates a timer from the clock of the group.
+func (g *Group[T]) newTimer(d time.Duration) Timer {
+	g.mutex.Lock()
+	clock := g.clockLocked()
+	g.mutex.Unlock()
+
+	return clock.NewTimer(d)
+}
+
+// withTimeout returns a context derived from ctx that is canceled once d
+// has elapsed on the clock of the group, like context.WithTimeout. Its Err
+// is then context.DeadlineExceeded.
+func (g *Group[T]) withTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	g.mutex.Lock()
+	clock := g.clockLocked()
+	g.mutex.Unlock()
+
+	inner, cancel := context.WithCancel(ctx)
+	tctx := &timeoutContext{Context: inner, deadline: clock.Now().Add(d)}
+	timer := clock.NewTimer(d)
+
+	go func() {
+		select {
+		case <-timer.C():
+			// A context already canceled keeps its error.
+			if inner.Err() == nil {
+				tctx.expired.Store(true)
+			}
+			cancel()
+		case <-inner.Done():
+			timer.Stop()
+		}
+	}()
+
+	return tctx, cancel
+}
+
+// timeoutContext is a context canceled by a timer of the clock of a group.
+type timeoutContext struct {
+	context.Context
+	deadline time.Time
+	expired  atomic.Bool
+}
+
+// Deadline returns the time on the clock of the group at which the context
+// is canceled, or the deadline of the parent context if it is earlier.
+func (c *timeoutContext) Deadline() (time.Time, bool) {
+	if deadline, ok := c.Context.Deadline(); ok && deadline.Before(c.deadline) {
+		return deadline, true
+	}
+
+	return c.deadline, true
+}
+
+// Err returns context.DeadlineExceeded if the context was canceled by its
+// timer, or the error of the underlying context.
+func (c *timeoutContext) Err() error {
+	err := c.Context.Err()
+	if err != nil && c.expired.Load() {
+		return context.DeadlineExceeded
+	}
+
+	return err
+}
+
+// schedule runs f with the scheduler of the group, or in a new goroutine.
+func (g *Group[T]) schedule(f func()) {
+	g.mutex.Lock()
+	scheduler := g.scheduler
+	g.mutex.Unlock()
+
+	if scheduler == nil {
+		go f()
+		return
+	}
+
+	scheduler.Schedule(f)
+}
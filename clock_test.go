@@ -0,0 +1,230 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a Clock whose time only moves with Advance.
+type fakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// fakeTimer is a Timer of a fakeClock.
+type fakeTimer struct {
+	at      time.Time
+	c       chan time.Time
+	stopped bool
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	t := &fakeTimer{at: c.now.Add(d), c: make(chan time.Time, 1)}
+	c.timers = append(c.timers, t)
+
+	return fakeTimerHandle{clock: c, t: t}
+}
+
+// Advance moves the time forward by d, and fires the timers that are due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+	for _, t := range c.timers {
+		if !t.stopped && !t.at.After(c.now) {
+			t.stopped = true
+			t.c <- c.now
+		}
+	}
+}
+
+// Timers returns the number of timers that have not fired or been stopped.
+func (c *fakeClock) Timers() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	n := 0
+	for _, t := range c.timers {
+		if !t.stopped {
+			n++
+		}
+	}
+
+	return n
+}
+
+// fakeTimerHandle implements Timer for a fakeTimer.
+type fakeTimerHandle struct {
+	clock *fakeClock
+	t     *fakeTimer
+}
+
+func (h fakeTimerHandle) C() <-chan time.Time { return h.t.c }
+
+func (h fakeTimerHandle) Stop() bool {
+	h.clock.mutex.Lock()
+	defer h.clock.mutex.Unlock()
+
+	stopped := h.t.stopped
+	h.t.stopped = true
+
+	return !stopped
+}
+
+func TestDeterministic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scheduler", testDeterministicScheduler)
+	t.Run("clock", testDeterministicClock)
+	t.Run("timeouts", testDeterministicTimeouts)
+	t.Run("checkpoint", testDeterministicCheckpoint)
+}
+
+// testDeterministicScheduler checks that a synchronous scheduler runs the tasks in submission order, so the threshold is hit deterministically.
+func testDeterministicScheduler(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetSkipOnCancel(true)
+	group.SetScheduler(SchedulerFunc(func(run func()) { run() }))
+
+	var order []int
+	for i := 0; i < 4; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			order = append(order, i)
+			if i == 1 {
+				return nil, err1
+			}
+
+			return []int{i}, nil
+		})
+	}
+
+	results, err := group.Wait()
+
+	assert.Equal(t, []int{0, 1}, order, "Expected the tasks to run in order until the threshold, got: %v", order)
+	assert.Equal(t, []int{0}, results, "Expected results to be: %v, got: %v", []int{0}, results)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected error to be: %v, got: %v", err1, err)
+}
+
+// testDeterministicClock checks that the delays of GoHedged and the task durations follow the fake clock.
+func testDeterministicClock(t *testing.T) {
+	t.Parallel()
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	group := Group[int]{}
+	group.SetClock(clock)
+
+	group.GoHedged(time.Minute,
+		func(ctx context.Context) ([]int, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		func(ctx context.Context) ([]int, error) {
+			clock.Advance(time.Second)
+			return []int{2}, nil
+		},
+	)
+
+	assert.Eventually(t, func() bool { return clock.Timers() == 1 }, time.Second, time.Millisecond, "Expected the hedging delay to wait on the clock")
+	clock.Advance(time.Minute)
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{2}, results, "Expected results to be: %v, got: %v", []int{2}, results)
+	assert.Equal(t, time.Minute+time.Second, group.Stats().Max, "Expected the duration from the clock, got: %v", group.Stats().Max)
+}
+
+// testDeterministicTimeouts checks that the task timeouts and WaitTimeout expire when the fake clock is advanced.
+func testDeterministicTimeouts(t *testing.T) {
+	t.Parallel()
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	group, _ := WithErrorsThreshold[int](context.Background(), 3)
+	group.SetClock(clock)
+	group.SetTaskTimeout(time.Minute)
+
+	group.GoCtx(func(ctx context.Context) ([]int, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	group.GoWithTimeout(time.Minute, func(ctx context.Context) ([]int, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	release := make(chan struct{})
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{3}, nil
+	})
+
+	assert.Eventually(t, func() bool { return clock.Timers() == 2 }, time.Second, time.Millisecond, "Expected the task timeouts to wait on the clock")
+	clock.Advance(time.Minute)
+	_, _ = group.WaitTask(0)
+	_, _ = group.WaitTask(1)
+
+	type outcome struct {
+		results []int
+		err     error
+	}
+	done := make(chan outcome)
+	go func() {
+		results, err := group.WaitTimeout(time.Hour)
+		done <- outcome{results, err}
+	}()
+
+	assert.Eventually(t, func() bool { return clock.Timers() == 1 }, time.Second, time.Millisecond, "Expected WaitTimeout to wait on the clock")
+	clock.Advance(time.Hour)
+	got := <-done
+	close(release)
+
+	errs := unwrapErrors(got.err)
+	assert.Len(t, errs, 3, "Expected 2 timeouts and ErrWaitIncomplete, got: %v", errs)
+	for _, err := range errs[:2] {
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "Expected a deadline error, got: %v", err)
+	}
+	assert.ErrorIs(t, got.err, ErrWaitIncomplete, "Expected error to be: %v, got: %v", ErrWaitIncomplete, got.err)
+}
+
+// testDeterministicCheckpoint checks that the checkpoint interval follows the fake clock.
+func testDeterministicCheckpoint(t *testing.T) {
+	t.Parallel()
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	group := Group[int]{}
+	group.SetClock(clock)
+
+	saved := make(chan []int, 1)
+	group.SetCheckpoint(time.Minute, func(results []int) error {
+		saved <- results
+		return nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+	_, _ = group.WaitTask(0)
+
+	assert.Eventually(t, func() bool { return clock.Timers() == 1 }, time.Second, time.Millisecond, "Expected the checkpoint to wait on the clock")
+	clock.Advance(time.Minute)
+	results := <-saved
+
+	_, _ = group.Wait()
+
+	assert.Equal(t, []int{1}, results, "Expected saved results to be: %v, got: %v", []int{1}, results)
+}
@@ -0,0 +1,77 @@
+package resultgroup
+
+// GoCollect works like Go for a function that pushes its results by calling
+// emit for each of them, which suits iterator-style producers. Each emitted
+// result is collected right away, without allocating a slice per task, so
+// options such as SetSink, SetBudget and SetMaxResults apply to every
+// emitted result, and results emitted before a panic are kept. The error
+// returned by the function is handled as with Go.
+// If results are discarded on error with SetKeepResultsOnError, ordered
+// with SetOrderedResults or sharded with SetShardedResults, they are
+// buffered instead and collected when the function returns. Otherwise
+// WaitTask reports no results for the task, only its error.
+// emit must not be called after the function returns.
+func (g *Group[T]) GoCollect(f func(emit func(T)) error) {
+	g.checkNil()
+
+	if !g.acquire(1) {
+		return
+	}
+
+	g.wg.Add(1)
+	t := g.addTask("")
+
+	g.schedule(func() {
+		defer g.done(1)
+
+		c := collector[T]{g: g, t: t}
+		g.run(t, func() ([]T, error) {
+			return c.collect(f)
+		})
+	})
+}
+
+// collector collects the results emitted by a GoCollect task.
+type collector[T any] struct {
+	g        *Group[T]
+	t        *task[T]
+	buffered bool
+	buf      []T
+	one      [1]T
+}
+
+// collect calls f and returns the buffered results along with its error.
+func (c *collector[T]) collect(f func(emit func(T)) error) ([]T, error) {
+	c.g.mutex.Lock()
+	c.buffered = c.g.discardOnError || c.g.ordered || c.g.sharded
+	c.g.mutex.Unlock()
+
+	err := f(c.emit)
+
+	return c.buf, err
+}
+
+// emit collects a single result, or buffers it if the results of the task
+// may have to be discarded.
+func (c *collector[T]) emit(v T) {
+	if c.buffered {
+		c.buf = append(c.buf, v)
+		return
+	}
+
+	c.t.emitted = true
+	g := c.g
+	g.mutex.Lock()
+
+	// The sink and the timeline may keep the slice, so they get their own.
+	c.one[0] = v
+	res := c.one[:]
+	if g.sink != nil || g.timed {
+		res = []T{v}
+	}
+
+	streamed := g.appendResults(c.t, res)
+	g.mutex.Unlock()
+
+	g.send(streamed)
+}
@@ -0,0 +1,101 @@
+package resultgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoCollect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testGoCollectResults)
+}
+
+// testGoCollectResults checks that emitted results are collected along with the task error.
+func testGoCollectResults(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	group.GoCollect(func(emit func(int)) error {
+		for i := 1; i <= 3; i++ {
+			emit(i)
+		}
+		return nil
+	})
+
+	group.GoCollect(func(emit func(int)) error {
+		emit(4)
+		return err1
+	})
+
+	group.GoCollect(func(emit func(int)) error {
+		return nil
+	})
+
+	results, err := group.Wait()
+
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3, 4}, results)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+
+	discarding := Group[int]{}
+	discarding.SetKeepResultsOnError(false)
+	discarding.SetEmptyResultAsError(err2)
+
+	discarding.GoCollect(func(emit func(int)) error {
+		emit(1)
+		return nil
+	})
+
+	discarding.GoCollect(func(emit func(int)) error {
+		emit(2)
+		return err1
+	})
+
+	discarding.GoCollect(func(emit func(int)) error {
+		return nil
+	})
+
+	results, err = discarding.Wait()
+
+	assert.Equal(t, []int{1}, results, "Expected the results of the failed task to be discarded, got: %v", results)
+	assert.ElementsMatch(t, []error{err1, err2}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1, err2}, err)
+}
+
+// produce calls yield for n values, like an iterator-style producer.
+func produce(n int, yield func(int)) {
+	for i := 0; i < n; i++ {
+		yield(i)
+	}
+}
+
+func BenchmarkGo(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		group := Group[int]{}
+		for j := 0; j < 10; j++ {
+			group.Go(func() ([]int, error) {
+				var res []int
+				produce(100, func(v int) {
+					res = append(res, v)
+				})
+				return res, nil
+			})
+		}
+		_, _ = group.Wait()
+	}
+}
+
+func BenchmarkGoCollect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		group := Group[int]{}
+		for j := 0; j < 10; j++ {
+			group.GoCollect(func(emit func(int)) error {
+				produce(100, emit)
+				return nil
+			})
+		}
+		_, _ = group.Wait()
+	}
+}
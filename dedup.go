@@ -0,0 +1,77 @@
+package resultgroup
+
+import "container/list"
+
+// SetDedupKey makes the group drop results whose key, as returned by key,
+// was already seen in the results collected so far, including the ones of
+// the same task. Passing a nil key disables deduplication.
+// SetDedupKey must be called before any task is started.
+func (g *Group[T]) SetDedupKey(key func(T) string) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.dedupKey = key
+	g.seen = nil
+	g.seenOrder = nil
+}
+
+// SetDedupWindow limits deduplication to the last size distinct keys seen,
+// evicting the least recently seen key first. It bounds the memory used by
+// long-running groups, at the cost of letting duplicates outside the window
+// through. A size of 0, the default, keeps every key.
+// It has no effect unless a key is set with SetDedupKey.
+func (g *Group[T]) SetDedupWindow(size int) {
+	g.checkNil()
+
+	if size < 0 {
+		panic("dedup window must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.dedupWindow = size
+}
+
+// dedup returns the results whose keys were not seen yet. It must be called
+// with the mutex held.
+func (g *Group[T]) dedup(res []T) []T {
+	if g.dedupKey == nil || len(res) == 0 {
+		return res
+	}
+
+	kept := make([]T, 0, len(res))
+	for _, r := range res {
+		if !g.seenKey(g.dedupKey(r)) {
+			kept = append(kept, r)
+		}
+	}
+
+	return kept
+}
+
+// seenKey reports whether key was already seen, and marks it as the most
+// recently seen key. It must be called with the mutex held.
+func (g *Group[T]) seenKey(key string) bool {
+	if g.seen == nil {
+		g.seen = make(map[string]*list.Element)
+		g.seenOrder = list.New()
+	}
+
+	if el, ok := g.seen[key]; ok {
+		g.seenOrder.MoveToFront(el)
+		return true
+	}
+
+	g.seen[key] = g.seenOrder.PushFront(key)
+
+	if g.dedupWindow > 0 && g.seenOrder.Len() > g.dedupWindow {
+		oldest := g.seenOrder.Back()
+		g.seenOrder.Remove(oldest)
+		delete(g.seen, oldest.Value.(string))
+	}
+
+	return false
+}
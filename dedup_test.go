@@ -0,0 +1,57 @@
+package resultgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("key", testDedupKey)
+	t.Run("window", testDedupWindow)
+}
+
+func identity(s string) string {
+	return s
+}
+
+// testDedupKey checks that results with an already seen key are dropped.
+func testDedupKey(t *testing.T) {
+	t.Parallel()
+	group := Group[string]{}
+	group.SetDedupKey(identity)
+
+	group.Go(func() ([]string, error) {
+		return []string{"a", "b", "a"}, nil
+	})
+
+	group.Go(func() ([]string, error) {
+		return []string{"b", "c"}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, results, "Expected results to be: %v, got: %v", []string{"a", "b", "c"}, results)
+}
+
+// testDedupWindow checks that keys outside the window are evicted and no longer deduplicated.
+func testDedupWindow(t *testing.T) {
+	t.Parallel()
+	group := Group[string]{}
+	group.SetDedupKey(identity)
+	group.SetDedupWindow(2)
+
+	group.Go(func() ([]string, error) {
+		return []string{"a", "b", "a", "c", "b", "c"}, nil
+	})
+
+	results, err := group.Wait()
+
+	// "b" is evicted by "c", because "a" was seen more recently.
+	expected := []string{"a", "b", "c", "b"}
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, expected, results, "Expected results to be: %v, got: %v", expected, results)
+}
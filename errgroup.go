@@ -0,0 +1,56 @@
+package resultgroup
+
+// GoVoid works like Go for a function that produces no results, such as a
+// task written for golang.org/x/sync/errgroup.
+func (g *Group[T]) GoVoid(f func() error) {
+	g.checkNil()
+
+	g.Go(voidTask[T](f))
+}
+
+// Errgroup is a view of a Group with the method set of errgroup.Group, so
+// code written against golang.org/x/sync/errgroup can be migrated
+// incrementally: the tasks submitted through it share the context,
+// threshold and limit of the Group with the tasks that return results.
+type Errgroup[T any] struct {
+	group *Group[T]
+}
+
+// Errgroup returns a view of the group with the method set of
+// errgroup.Group.
+func (g *Group[T]) Errgroup() Errgroup[T] {
+	g.checkNil()
+
+	return Errgroup[T]{group: g}
+}
+
+// Go runs f in a new goroutine, like Group.GoVoid.
+func (e Errgroup[T]) Go(f func() error) {
+	e.group.GoVoid(f)
+}
+
+// TryGo runs f in a new goroutine only if the limit allows it, like
+// Group.TryGo.
+func (e Errgroup[T]) TryGo(f func() error) bool {
+	return e.group.TryGo(voidTask[T](f))
+}
+
+// SetLimit limits the number of running tasks, like Group.SetLimit.
+func (e Errgroup[T]) SetLimit(n int) {
+	e.group.SetLimit(n)
+}
+
+// Wait waits for the tasks like Group.Wait, and returns only the error.
+// The results are still collected, and returned by later calls to
+// Group.Wait.
+func (e Errgroup[T]) Wait() error {
+	_, err := e.group.Wait()
+	return err
+}
+
+// voidTask adapts a function without results to a task.
+func voidTask[T any](f func() error) func() ([]T, error) {
+	return func() ([]T, error) {
+		return nil, f()
+	}
+}
@@ -0,0 +1,39 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestErrgroup checks that tasks without results share the threshold and context of the group.
+func TestErrgroup(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithFailFast[int](context.Background())
+	eg := group.Errgroup()
+	eg.SetLimit(1)
+
+	release := make(chan struct{})
+	eg.Go(func() error {
+		<-release
+		return err1
+	})
+
+	ok := eg.TryGo(func() error {
+		return nil
+	})
+	assert.False(t, ok, "Expected TryGo to fail while the limit is reached, got: %v", ok)
+	close(release)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, ctx.Err()
+	})
+	group.GoVoid(func() error {
+		return nil
+	})
+
+	err := eg.Wait()
+
+	assert.Equal(t, err1, err, "Expected error to be: %v, got: %v", err1, err)
+}
@@ -1,28 +1,193 @@
 package resultgroup
 
-// errorWithUnwrap is an interface that represents an error with the ability to
-// unwrap the underlying errors. This interface is compatible with Go 1.20
-// wrapped errors.
-type errorWithUnwrap interface {
-	error
-	Unwrap() []error
-}
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrBudgetExceeded is returned by Wait when the total cost of the results
+// exceeds the budget set with SetBudget.
+var ErrBudgetExceeded = errors.New("resultgroup: budget exceeded")
+
+// ErrWeightExceedsLimit is returned by GoWeight when the weight of the task
+// exceeds the limit set with SetLimit, so the task could never run.
+var ErrWeightExceedsLimit = errors.New("resultgroup: task weight exceeds the limit")
+
+// ErrDuplicateKey is wrapped by the error recorded when a KeyedGroup task is
+// submitted with a key that was already submitted.
+var ErrDuplicateKey = errors.New("resultgroup: duplicate key")
+
+// ErrWaitIncomplete is joined with the errors returned by WaitTimeout when it
+// returns before all tasks have returned.
+var ErrWaitIncomplete = errors.New("resultgroup: wait incomplete")
+
+// ErrThresholdReached is the cause of the cancellation of a group context
+// whose error threshold, or error rate threshold, is reached. The cause,
+// returned by context.Cause, also wraps the errors collected when the
+// threshold was reached, so tasks can tell this cancellation from the
+// cancellation of the parent context or a deadline.
+var ErrThresholdReached = errors.New("resultgroup: error threshold reached")
 
-type multiError struct {
-	errs []error
+// ErrCircuitOpen is reported for the tasks that were not run because the
+// circuit breaker set with SetCircuitBreaker is open, and joined once with
+// the errors returned by Wait.
+var ErrCircuitOpen = errors.New("resultgroup: circuit open")
+
+// ErrTaskSkipped is recorded, wrapped in a TaskError, for each task that was
+// still running when a Group created with WithGracefulDeadline stopped
+// waiting for it. It is also reported by WaitTask and WaitDetailed for the
+// tasks that were not run because of SetSkipOnCancel.
+var ErrTaskSkipped = errors.New("resultgroup: task skipped")
+
+// MultiError holds the errors collected by a Group, and is the type of the
+// error returned by Wait. It implements Unwrap() []error, so it is
+// compatible with Go 1.20 wrapped errors, and it can be retrieved with
+// errors.As.
+type MultiError struct {
+	errs      []error
+	counts    []int
+	triggered int
+	omitted   int
+	format    func([]error) string
 }
 
-func (me *multiError) Error() string {
+func (me *MultiError) Error() string {
+	if me.format != nil {
+		return me.format(me.errs)
+	}
+
 	var b []byte
 	for i, err := range me.errs {
 		if i > 0 {
 			b = append(b, '\n')
 		}
-		b = append(b, err.Error()...)
+		b = append(b, me.message(i, err)...)
+	}
+	if me.omitted > 0 {
+		b = append(b, fmt.Sprintf("\n(%d more errors omitted)", me.omitted)...)
 	}
 	return string(b)
 }
 
-func (me *multiError) Unwrap() []error {
+// message returns the message of the i-th error, err, with its number of
+// occurrences if it occurred more than once.
+func (me *MultiError) message(i int, err error) string {
+	if n := me.count(i); n > 1 {
+		return fmt.Sprintf("%s (x%d)", err.Error(), n)
+	}
+	return err.Error()
+}
+
+func (me *MultiError) Unwrap() []error {
+	return me.errs
+}
+
+// Errors returns the collected errors.
+func (me *MultiError) Errors() []error {
 	return me.errs
 }
+
+// Triggering returns the errors up to the one that reached the threshold of
+// the Group, or all the errors if it was not reached. It differs from Errors
+// only for Groups that keep collecting errors past the threshold, enabled by
+// SetFullErrorCollection.
+func (me *MultiError) Triggering() []error {
+	if me.triggered == 0 {
+		return me.errs
+	}
+	return me.errs[:me.triggered]
+}
+
+// Counts returns the number of occurrences of each error returned by
+// Errors, for Groups with deduplicated errors enabled by SetDedupeErrors.
+// Without deduplication every error occurred once.
+func (me *MultiError) Counts() []int {
+	counts := make([]int, len(me.errs))
+	for i := range counts {
+		counts[i] = me.count(i)
+	}
+	return counts
+}
+
+// Omitted returns the number of errors left out of the sample kept by a
+// Group with a sample size set by SetErrorSampleSize, or the number of
+// distinct messages left out if errors are also deduplicated.
+func (me *MultiError) Omitted() int {
+	return me.omitted
+}
+
+func (me *MultiError) count(i int) int {
+	if i < len(me.counts) {
+		return me.counts[i]
+	}
+	return 1
+}
+
+// Join returns a *MultiError that wraps the given errors, discarding nil
+// errors, or nil if errs contains no non-nil errors. Its message is the same
+// as the one of errors.Join: the messages of the errors, separated by
+// newlines.
+func Join(errs ...error) error {
+	var joined []error
+	for _, err := range errs {
+		if err != nil {
+			joined = append(joined, err)
+		}
+	}
+
+	if len(joined) == 0 {
+		return nil
+	}
+
+	return &MultiError{errs: joined}
+}
+
+// TaskError wraps the error of a task with details about the task, for
+// Groups with rich errors enabled by SetRichErrors and for tasks submitted
+// with GoNamed. Use errors.As to retrieve it from the error returned by Wait.
+type TaskError struct {
+	// Index is the submission index of the task, counting from 0.
+	Index int
+	// Label is the label given to GoNamed, or empty.
+	Label string
+	// Stack is the stack trace of the Go call that submitted the task, or
+	// nil without rich errors.
+	Stack []byte
+	// Duration is how long the task function ran.
+	Duration time.Duration
+	// Err is the error returned by the task.
+	Err error
+}
+
+func (e *TaskError) Error() string {
+	if e.Label != "" {
+		return fmt.Sprintf("task %d (%s): %v", e.Index, e.Label, e.Err)
+	}
+	return fmt.Sprintf("task %d: %v", e.Index, e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError is recorded as the error of a task that panicked. It holds the
+// recovered value and the stack trace of the panic, and counts toward the
+// threshold like any other error. Use errors.As with a *PanicError target to
+// retrieve it from the error returned by Wait.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+func (e PanicError) Error() string {
+	return fmt.Sprintf("resultgroup: task panicked: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, and nil otherwise.
+func (e PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
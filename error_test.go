@@ -0,0 +1,37 @@
+package resultgroup
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil", testJoinNil)
+	t.Run("errors.Join compatible", testJoinCompatible)
+}
+
+// testJoinNil checks that joining no errors, or only nil errors, returns nil.
+func testJoinNil(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, Join(), "Expected no error")
+	assert.NoError(t, Join(nil, nil), "Expected no error")
+}
+
+// testJoinCompatible checks that Join has the same message and unwrapped errors as errors.Join.
+func testJoinCompatible(t *testing.T) {
+	t.Parallel()
+
+	for _, errs := range [][]error{{err1}, {err1, nil, err2}, {err1, err2, err3}} {
+		joined := Join(errs...)
+		expected := errors.Join(errs...)
+
+		assert.Equal(t, expected.Error(), joined.Error(), "Expected the message of errors.Join, got: %v", joined)
+		assert.Equal(t, expected.(interface{ Unwrap() []error }).Unwrap(), unwrapErrors(joined),
+			"Expected the errors of errors.Join, got: %v", unwrapErrors(joined))
+	}
+}
@@ -0,0 +1,114 @@
+package resultgroup
+
+import (
+	"context"
+	"time"
+)
+
+// SetSink makes the group deliver each task's results to sink as soon as
+// they are collected, in addition to returning them from Wait.
+// The sink is called with the group mutex held, so calls are serialized,
+// and it must not call methods of the group. The results of each task are
+// then not kept with it, so WaitTask and WaitDetailed report no results.
+func (g *Group[T]) SetSink(sink func([]T)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.sink = sink
+}
+
+// SetCancelGrace limits how long Wait waits for running tasks once the group
+// context is canceled, by the threshold or by the caller. Tasks that finish
+// during the grace period still deliver their results, to the sink set with
+// SetSink and to Wait, so work that was nearly done is not lost.
+// After the grace period Wait returns without waiting for the remaining
+// tasks, and their results and errors are discarded. Tasks that ignore the
+// context entirely are therefore not captured.
+// A grace period of 0, the default, makes Wait wait for every task.
+// SetCancelGrace has no effect on a Group without a context.
+func (g *Group[T]) SetCancelGrace(grace time.Duration) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.grace = grace
+}
+
+// WithGracefulDeadline creates a new Group with the provided context whose
+// Wait returns promptly once the context is canceled or its deadline
+// expires: it waits at most grace for the running tasks to notice the
+// cancellation, then returns the results collected so far. Each task still
+// running is reported by a TaskError wrapping ErrTaskSkipped, so callers can
+// tell partial results from complete ones; these errors do not count toward
+// a threshold. The results and errors of skipped tasks are discarded, as
+// with SetCancelGrace.
+// Grace must be greater than 0.
+func WithGracefulDeadline[T any](ctx context.Context, grace time.Duration) (group Group[T], groupCtx context.Context) {
+	if grace <= 0 {
+		panic("grace period must be greater than 0")
+	}
+
+	group.grace, group.tagSkips = grace, true
+	groupCtx = group.initContext(ctx)
+
+	return
+}
+
+// waitTasks blocks until all tasks have returned, or until the grace period
+// has passed since the group context was canceled. It reports whether all
+// tasks have returned. Once the grace period has passed, later calls return
+// false at once, without waiting again.
+func (g *Group[T]) waitTasks() bool {
+	g.mutex.Lock()
+	grace, expired := g.grace, g.graceExpired
+	g.mutex.Unlock()
+
+	if grace <= 0 || g.ctx == nil {
+		g.wg.Wait()
+		return true
+	}
+
+	if expired {
+		return false
+	}
+
+	done := g.allDone()
+
+	select {
+	case <-done:
+		return true
+	case <-g.ctx.Done():
+	}
+
+	timer := g.newTimer(grace)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return true
+	case <-timer.C():
+		g.mutex.Lock()
+		g.closed = true
+		g.graceExpired = true
+		if g.tagSkips {
+			g.skipRunning()
+		}
+		g.mutex.Unlock()
+
+		return false
+	}
+}
+
+// skipRunning records an ErrTaskSkipped error, once, for each task whose
+// outcome is not collected. It must be called with the mutex held.
+func (g *Group[T]) skipRunning() {
+	for _, t := range g.tasks {
+		if !t.processed && !t.skipped {
+			t.skipped = true
+			g.appendError(&TaskError{Index: t.index, Label: t.label, Err: ErrTaskSkipped})
+		}
+	}
+}
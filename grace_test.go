@@ -0,0 +1,116 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCancelGrace checks that tasks finishing during the grace period still deliver their results to the sink.
+func TestCancelGrace(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetCancelGrace(100 * time.Millisecond)
+
+	var (
+		mu   sync.Mutex
+		sunk []int
+	)
+
+	group.SetSink(func(res []int) {
+		mu.Lock()
+		defer mu.Unlock()
+		sunk = append(sunk, res...)
+	})
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		return []int{1}, nil
+	})
+
+	release := make(chan struct{})
+	defer close(release)
+
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{2}, nil
+	})
+
+	start := time.Now()
+	results, err := group.Wait()
+
+	assert.Less(t, time.Since(start), time.Second, "Expected Wait to return after the grace period")
+	assert.Equal(t, err1, unwrapErrors(err)[0], "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []int{1}, sunk, "Expected sunk results to be: %v, got: %v", []int{1}, sunk)
+}
+
+// TestGracefulDeadline checks that Wait returns the completed results once the deadline expires, and tags the running tasks as skipped.
+func TestGracefulDeadline(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	group, _ := WithGracefulDeadline[int](ctx, 10*time.Millisecond)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	release := make(chan struct{})
+	defer close(release)
+
+	group.GoNamed("slow", func() ([]int, error) {
+		<-release
+		return []int{2}, nil
+	})
+
+	start := time.Now()
+	results, err := group.Wait()
+
+	assert.Less(t, time.Since(start), time.Second, "Expected Wait to return after the grace period")
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.ErrorIs(t, err, ErrTaskSkipped, "Expected error to be: %v, got: %v", ErrTaskSkipped, err)
+
+	var taskErr *TaskError
+	assert.ErrorAs(t, err, &taskErr, "Expected a TaskError, got: %v", err)
+	assert.Equal(t, "slow", taskErr.Label, "Expected label to be: %v, got: %v", "slow", taskErr.Label)
+}
+
+// TestGracefulDeadlineWaitTwice checks that a second Wait returns at once with the same errors, each skipped task being tagged once.
+func TestGracefulDeadlineWaitTwice(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	group, _ := WithGracefulDeadline[int](ctx, 10*time.Millisecond)
+	group.SetDedupeErrors(true)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	group.Go(func() ([]int, error) {
+		<-release
+		return nil, nil
+	})
+	cancel()
+
+	_, first := group.Wait()
+	start := time.Now()
+	_, second := group.Wait()
+
+	assert.Less(t, time.Since(start), 10*time.Millisecond, "Expected the second Wait not to wait for the grace period again")
+	assert.Equal(t, first.Error(), second.Error(), "Expected the same errors, got: %v", second)
+	assert.Len(t, unwrapErrors(second), 1, "Expected the task to be tagged once, got: %v", second)
+}
@@ -1,95 +1,1734 @@
 package resultgroup
 
 import (
+	"container/list"
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime/debug"
 	"sync"
+	"time"
 )
 
+// errNilGroup is the panic message for methods called on a nil *Group.
+const errNilGroup = "resultgroup: method called on nil *Group"
+
+// errWaited is the panic message for tasks submitted after Wait returned.
+const errWaited = "resultgroup: task submitted after Wait returned; call Reset to reuse the group"
+
 // Group is a generic struct that holds errors and results from concurrent tasks.
 // To create a Group without a context and error threshold, use the struct directly:
 // group := resultgroup.Group[T]{}
 type Group[T any] struct {
 	mutex     sync.Mutex
 	errs      []error
+	errCount  int
+	dropped   int
 	wg        sync.WaitGroup
-	cancel    func()
+	cancel    context.CancelCauseFunc
+	canceled  bool
 	threshold int
 	results   []T
+	parent    context.Context
+	ctx       context.Context
+	gate      chan struct{}
+	failFast  bool
+
+	errRate     float64
+	minSamples  int
+	rateReached bool
+
+	consecutive int
+	circuitOpen bool
+
+	tasks     []*task[T]
+	completed int
+	failed    int
+	collected int
+	inFlight  int
+	pending   int
+	running   int
+	peak      int
+	sem       *semaphore
+	limiter   Limiter
+	breaker   int
+	adaptive  *adaptiveLimit
+	inst      Instrumentation
+	starters  []chan struct{}
+	onceKeys  map[string]struct{}
+
+	noCancelOnWait bool
+	skipOnCancel   bool
+	emptyErr       error
+	discardOnError bool
+	rich           bool
+	taskTimeout    time.Duration
+	maxResults     int
+	overflow       OverflowPolicy
+	quorum         bool
+	onComplete     func(completed, errors int)
+	onTaskStart    func(index int)
+	onTaskDone     func(index, results int, err error)
+	onThreshold    func()
+	wrapper        func(ctx context.Context, run func())
+	clock          Clock
+	scheduler      Scheduler
+	forward        func(error)
+	errFormat      func([]error) string
+	errJoin        func([]error) error
+	ordered        bool
+	capacity       int
+	sharded        bool
+	shardLen       int
+	policy         func(error) Decision
+
+	fullErrors  bool
+	triggered   int
+	dedupeErrs  bool
+	countUnique bool
+	errIndex    map[string]int
+	errCounts   []int
+	occurrences int
+	sampleSize  int
+	unsampled   int
+
+	sink      func([]T)
+	filter    func(T) (T, bool)
+	noCollect bool
+	closed    bool
+	aborted   bool
+	waited    bool
+
+	grace        time.Duration
+	tagSkips     bool
+	graceExpired bool
+
+	stream       chan T
+	streamBuffer int
+	subscribers  []chan T
+	streamClosed bool
+
+	score         func([]T) float64
+	target        float64
+	targetReached bool
+
+	cost           func([]T) int
+	budget         int
+	spent          int
+	budgetExceeded bool
+
+	dedupKey    func(T) string
+	dedupWindow int
+	seen        map[string]*list.Element
+	seenOrder   *list.List
+
+	timeline []TimelineEntry[T]
+	timed    bool
+
+	checkpointStop chan struct{}
+	checkpointDone chan struct{}
+	checkpointErrs int
+
+	// noTaskResults is set for groups whose tasks cannot be waited for one
+	// by one, so the results of each task need not be kept with it.
+	noTaskResults bool
 }
 
 // WithErrorsThreshold creates a new Group with the provided context
 // and a threshold for the maximum number of errors.
-// If the threshold is reached, the context will be canceled.
+// If the threshold is reached, the context will be canceled, and
+// context.Cause returns an error wrapping ErrThresholdReached and the
+// collected errors.
 // Threshold must be greater than or equal to 1.
-func WithErrorsThreshold[T any](ctx context.Context, threshold int) (Group[T], context.Context) {
+func WithErrorsThreshold[T any](ctx context.Context, threshold int) (group Group[T], groupCtx context.Context) {
+	groupCtx = group.initThreshold(ctx, threshold)
+	return
+}
+
+// initContext derives the group context from ctx, so the group can cancel
+// it, and returns it. The constructors call it on the zero Group, or the
+// wrapper holding one, that they then return by value, like the struct
+// literals they replace: the Group is copied before any task is submitted,
+// while its mutex is unlocked. Reset calls it to derive a fresh context.
+func (g *Group[T]) initContext(ctx context.Context) context.Context {
+	g.parent = ctx
+	g.ctx, g.cancel = context.WithCancelCause(ctx)
+
+	return g.ctx
+}
+
+// initThreshold works like initContext, and sets the threshold, as done by
+// WithErrorsThreshold.
+func (g *Group[T]) initThreshold(ctx context.Context, threshold int) context.Context {
 	if threshold < 1 {
 		panic("threshold must be greater than or equal to 1")
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
+	g.threshold = threshold
+
+	return g.initContext(ctx)
+}
+
+// WithErrorRatio creates a new Group with the provided context and a
+// threshold computed as a ratio of the expected number of tasks, rounded up.
+// For example, a ratio of 0.1 with 20 expected tasks cancels the context on
+// the second error. A ratio of 0 cancels on the first error.
+// The threshold is computed once, and it stays the same if the actual number
+// of tasks differs from expectedTasks.
+// Ratio must be between 0 and 1, and expectedTasks must be greater than or
+// equal to 1.
+func WithErrorRatio[T any](ctx context.Context, ratio float64, expectedTasks int) (Group[T], context.Context) {
+	if ratio < 0 || ratio > 1 {
+		panic("ratio must be between 0 and 1")
+	}
+
+	if expectedTasks < 1 {
+		panic("expected tasks must be greater than or equal to 1")
+	}
+
+	// The epsilon keeps ratios that are not exactly representable, like 0.1,
+	// from rounding up to the next task.
+	threshold := int(math.Ceil(ratio*float64(expectedTasks) - 1e-9))
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return WithErrorsThreshold[T](ctx, threshold)
+}
+
+// WithErrorRateThreshold creates a new Group with the provided context that
+// cancels the context once more than rate of the completed tasks have
+// failed, which scales with the number of tasks unlike an absolute
+// threshold. The rate is only checked once minSamples tasks have completed,
+// so the first failures of a large group do not cancel it on their own.
+// Errors are collected whatever the rate.
+// Rate must be between 0 and 1, and minSamples must be greater than or
+// equal to 1.
+func WithErrorRateThreshold[T any](ctx context.Context, rate float64, minSamples int) (group Group[T], groupCtx context.Context) {
+	if rate < 0 || rate > 1 {
+		panic("rate must be between 0 and 1")
+	}
+
+	if minSamples < 1 {
+		panic("min samples must be greater than or equal to 1")
+	}
+
+	group.errRate, group.minSamples = rate, minSamples
+	groupCtx = group.initContext(ctx)
+
+	return
+}
+
+// checkErrorRate cancels the group context once the error rate set with
+// WithErrorRateThreshold is exceeded, and reports whether it was exceeded
+// by the last completed task. It must be called with the mutex held.
+func (g *Group[T]) checkErrorRate() bool {
+	if g.minSamples == 0 || g.rateReached || g.completed < g.minSamples {
+		return false
+	}
+
+	// As in WithErrorRatio, the epsilon absorbs the rounding of rates that
+	// are not exactly representable.
+	if float64(g.failed) <= g.errRate*float64(g.completed)+1e-9 {
+		return false
+	}
+
+	g.rateReached = true
+	g.record(EventThresholdReached, nil, nil)
+	g.cancelCauseLocked(g.thresholdCause())
+
+	return true
+}
+
+// WithFailFast creates a new Group with the provided context that cancels
+// the context on the first error, like errgroup.WithContext.
+// Unlike a Group created with a threshold of 1, Wait returns the first error
+// as is instead of wrapping it. Results collected before the failure are
+// still returned by Wait.
+func WithFailFast[T any](ctx context.Context) (group Group[T], groupCtx context.Context) {
+	group.failFast = true
+	groupCtx = group.initThreshold(ctx, 1)
+
+	return
+}
+
+// WithResultsTarget creates a new Group with the provided context that
+// cancels the context once n results are collected, for hedged requests and
+// quorum reads that only need the first n successful responses. Further
+// results are discarded, like with SetMaxResults, and the errors returned
+// once the target is reached, usually by the tasks that were stopped, are
+// dropped, so Wait returns no error for a reached target. Errors returned
+// before are collected without a threshold.
+// N must be greater than or equal to 1.
+func WithResultsTarget[T any](ctx context.Context, n int) (group Group[T], groupCtx context.Context) {
+	if n < 1 {
+		panic("results target must be greater than or equal to 1")
+	}
+
+	group.maxResults, group.quorum = n, true
+	groupCtx = group.initContext(ctx)
 
-	return Group[T]{cancel: cancel, threshold: threshold}, ctx
+	return
+}
+
+// WithContext creates a new Group that behaves like errgroup.WithContext:
+// the first error cancels the context and is returned by Wait as is.
+// It is the same as WithFailFast, named after errgroup to ease migrating
+// code that needs result collection on top of it.
+func WithContext[T any](ctx context.Context) (Group[T], context.Context) {
+	return WithFailFast[T](ctx)
 }
 
 // Go runs the provided function in a new goroutine and append the results
 // to aggregated slice that will be returned by Wait.
 // If the function returns an error, it will be appended to the aggregated
-// slice of errors if the threshold is not reached.
+// slice of errors if the threshold is not reached. The results returned
+// along with an error are still collected, unless disabled with
+// SetKeepResultsOnError.
+// If a limit is set with SetLimit, Go blocks until the number of running
+// tasks is below the limit. If the group context is canceled meanwhile, the
+// function is not run.
 func (g *Group[T]) Go(f func() ([]T, error)) {
+	g.checkNil()
+
+	if !g.acquire(1) {
+		return
+	}
+
+	g.start(1, "", f)
+}
+
+// GoNamed works like Go, but labels the task, so that its error is wrapped
+// in a *TaskError carrying the label, which can be retrieved with errors.As.
+// Labels do not need to be unique.
+func (g *Group[T]) GoNamed(label string, f func() ([]T, error)) {
+	g.checkNil()
+
+	if !g.acquire(1) {
+		return
+	}
+
+	g.start(1, label, f)
+}
+
+// GoOnce works like GoNamed with key as the label, but runs f only for the
+// first task submitted with key since the group was created or reset, so
+// overlapping requests of a fan-out do not duplicate work. Later tasks with
+// the same key are not run: their results are the ones of the first task,
+// collected once. GoOnce reports whether f was submitted.
+func (g *Group[T]) GoOnce(key string, f func() ([]T, error)) bool {
+	g.checkNil()
+
+	g.mutex.Lock()
+	_, dup := g.onceKeys[key]
+	waited := g.waited
+	if !dup && !waited {
+		if g.onceKeys == nil {
+			g.onceKeys = make(map[string]struct{})
+		}
+		g.onceKeys[key] = struct{}{}
+	}
+	g.mutex.Unlock()
+
+	if waited {
+		panic(errWaited)
+	}
+
+	if dup || !g.acquire(1) {
+		return false
+	}
+
+	g.start(1, key, f)
+
+	return true
+}
+
+// GoWeight works like Go, but the task occupies w slots of the limit set
+// with SetLimit instead of one, so heavy tasks can be bounded by the
+// resources they use rather than by their number. Without a limit the
+// weight is ignored.
+// If w exceeds the limit, the task could never run: GoWeight returns
+// ErrWeightExceedsLimit without running it instead of blocking forever.
+func (g *Group[T]) GoWeight(w int64, f func() ([]T, error)) error {
+	g.checkNil()
+
+	if w < 1 {
+		panic("weight must be greater than or equal to 1")
+	}
+
+	g.mutex.Lock()
+	sem := g.sem
+	g.mutex.Unlock()
+
+	if sem == nil {
+		w = 0
+	} else if w > sem.max {
+		return ErrWeightExceedsLimit
+	}
+
+	if g.acquire(w) {
+		g.start(w, "", f)
+	}
+
+	return nil
+}
+
+// GoOne works like Go for a function that produces a single result.
+// The result is collected only if the function returns no error.
+func (g *Group[T]) GoOne(f func() (T, error)) {
+	g.checkNil()
+
+	g.Go(func() ([]T, error) {
+		v, err := f()
+		if err != nil {
+			return nil, err
+		}
+
+		return []T{v}, nil
+	})
+}
+
+// GoCtx works like Go, but passes the group context to the function, so it
+// does not need to be captured from the constructor. A Group without a
+// context passes context.Background().
+// If a timeout is set with SetTaskTimeout, the function receives a context
+// derived from the group context that is canceled once the timeout elapses.
+// If the group context is already canceled, the function is not run: the
+// task is still registered, so the indices of WaitTask follow the order of
+// submission, and it is skipped with the context error.
+func (g *Group[T]) GoCtx(f func(ctx context.Context) ([]T, error)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	timeout := g.taskTimeout
+	g.mutex.Unlock()
+
+	ctx := g.context()
+	if err := ctx.Err(); err != nil {
+		g.skipSubmitted(err)
+		return
+	}
+
+	g.Go(func() ([]T, error) {
+		if timeout <= 0 {
+			return f(ctx)
+		}
+
+		ctx, cancel := g.withTimeout(ctx, timeout)
+		defer cancel()
+
+		return f(ctx)
+	})
+}
+
+// SetTaskTimeout sets a timeout for each task started with GoCtx, measured
+// from the moment the task starts. Each task gets its own deadline, and a
+// task that runs out of time does not cancel the group context: the error
+// it returns, usually context.DeadlineExceeded, counts toward the threshold
+// like any other error. A timeout of 0, the default, disables it.
+func (g *Group[T]) SetTaskTimeout(timeout time.Duration) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.taskTimeout = timeout
+}
+
+// GoWithTimeout works like GoCtx, but the function receives a context
+// derived from the group context that is canceled once d elapses. If the
+// task overruns its deadline, its results are discarded and it records
+// context.DeadlineExceeded, wrapped with the timeout, which counts toward
+// the threshold; an error of its own, or the error of an expired group
+// context, is recorded as is. With SetRichErrors the error is also wrapped
+// in a TaskError identifying the task.
+// It does not use the timeout set with SetTaskTimeout.
+func (g *Group[T]) GoWithTimeout(d time.Duration, f func(ctx context.Context) ([]T, error)) {
+	g.checkNil()
+
+	parent := g.context()
+	if err := parent.Err(); err != nil {
+		g.skipSubmitted(err)
+		return
+	}
+
+	g.Go(func() ([]T, error) {
+		ctx, cancel := g.withTimeout(parent, d)
+		defer cancel()
+
+		res, err := f(ctx)
+		// A deadline of the group context is not the timeout of the task.
+		if ctx.Err() == context.DeadlineExceeded && parent.Err() == nil && (err == nil || errors.Is(err, context.DeadlineExceeded)) {
+			return nil, fmt.Errorf("resultgroup: task timed out after %v: %w", d, context.DeadlineExceeded)
+		}
+
+		return res, err
+	})
+}
+
+// context returns the group context, or context.Background() for a Group
+// without a context.
+func (g *Group[T]) context() context.Context {
+	if g.ctx == nil {
+		return context.Background()
+	}
+
+	return g.ctx
+}
+
+// TryGo runs the provided function in a new goroutine only if the number of
+// running tasks is below the limit set with SetLimit, and reports whether
+// it did. Without a limit TryGo always runs the function, like Go.
+func (g *Group[T]) TryGo(f func() ([]T, error)) bool {
+	g.checkNil()
+
+	g.mutex.Lock()
+	sem, aborted, waited := g.sem, g.aborted, g.waited
+	g.mutex.Unlock()
+
+	if waited {
+		panic(errWaited)
+	}
+
+	if aborted || sem != nil && !sem.tryAcquire(1) {
+		return false
+	}
+
+	g.start(1, "", f)
+
+	return true
+}
+
+// GoPriority works like Go, but does not block: with a limit set with
+// SetLimit, the task waits in a queue until a slot is available, and tasks
+// with a higher priority are started before the ones with a lower priority,
+// whatever the order they were submitted in. Tasks with the same priority
+// start in submission order, and tasks submitted with Go have a priority
+// of 0. Queued tasks count as running for SetLimit and Reset.
+// If the group context is canceled while the task is queued, it is not run,
+// and WaitTask returns the context error for it.
+func (g *Group[T]) GoPriority(priority int, f func() ([]T, error)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	sem, aborted, waited := g.sem, g.aborted, g.waited
+	g.mutex.Unlock()
+
+	if waited {
+		panic(errWaited)
+	}
+
+	if aborted {
+		return
+	}
+
+	if sem == nil {
+		g.start(1, "", f)
+		return
+	}
+
 	g.wg.Add(1)
+	t := g.addTask("")
 
-	go func() {
-		defer g.wg.Done()
+	g.schedule(func() {
+		if err := sem.acquirePriority(g.ctx, 1, priority); err != nil {
+			defer g.done(0)
 
-		res, err := f()
-		g.processResult(res, err)
-	}()
+			g.skip(t, err)
+			return
+		}
+
+		defer g.done(1)
+
+		g.run(t, f)
+	})
 }
 
-func (g *Group[T]) processResult(res []T, err error) {
-	if err != nil {
-		g.handleErrors(err)
+// SetLimit limits the number of tasks running at the same time to at most n.
+// A negative value indicates no limit, which is the default.
+// The limit must not be modified while any tasks are running: SetLimit
+// panics in that case.
+func (g *Group[T]) SetLimit(n int) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.inFlight != 0 {
+		panic(fmt.Errorf("resultgroup: modify limit while %v tasks are still running", g.inFlight))
+	}
+
+	g.adaptive = nil
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+
+	g.sem = newSemaphore(int64(n))
+}
+
+// acquire blocks until w slots are available under the limit, if any.
+// It returns false if the group context is canceled first.
+func (g *Group[T]) acquire(w int64) bool {
+	g.mutex.Lock()
+	sem, aborted, waited := g.sem, g.aborted, g.waited
+	g.mutex.Unlock()
+
+	if waited {
+		panic(errWaited)
+	}
+
+	if aborted {
+		return false
+	}
+
+	if sem == nil {
+		return true
+	}
+
+	return sem.acquire(g.ctx, w) == nil
+}
+
+// start runs f as a new task with the given label in its own goroutine. The
+// caller must have acquired w slots under the limit.
+func (g *Group[T]) start(w int64, label string, f func() ([]T, error)) {
+	g.wg.Add(1)
+	t := g.addTask(label)
+
+	g.schedule(func() {
+		defer g.done(w)
+
+		g.run(t, f)
+	})
+}
+
+// done releases the resources held by a finished task of weight w.
+func (g *Group[T]) done(w int64) {
+	g.mutex.Lock()
+	g.inFlight--
+	g.reportInFlight()
+	sem := g.sem
+	g.mutex.Unlock()
+
+	if sem != nil && w > 0 {
+		sem.release(w)
 	}
 
-	g.appendResults(res)
+	g.wg.Done()
+}
+
+// Pause stops the group from starting new tasks until Resume is called.
+// Tasks that are already running are not affected and finish as usual.
+// Tasks submitted or scheduled while the group is paused wait for Resume
+// before they start. If the group context is canceled meanwhile, they are
+// not run: they are skipped, and WaitTask reports the context error for
+// them, which is not collected.
+func (g *Group[T]) Pause() {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.gate == nil {
+		g.gate = make(chan struct{})
+	}
 }
 
-func (g *Group[T]) handleErrors(err error) {
+// Resume lets the group start tasks again after Pause.
+// Calling Resume on a group that is not paused has no effect.
+func (g *Group[T]) Resume() {
+	g.checkNil()
+
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
 
-	if g.threshold == 0 || len(g.errs) < g.threshold {
-		g.errs = append(g.errs, err)
+	if g.gate != nil {
+		close(g.gate)
+		g.gate = nil
 	}
+}
+
+// checkNil panics with a clear message if g is nil.
+func (g *Group[T]) checkNil() {
+	if g == nil {
+		panic(errNilGroup)
+	}
+}
 
-	if len(g.errs) == g.threshold {
-		if g.cancel != nil {
-			g.cancel()
+// waitResumed blocks while the group is paused. It returns the error of
+// the group context if it is canceled while the group is paused.
+func (g *Group[T]) waitResumed() error {
+	for {
+		g.mutex.Lock()
+		gate := g.gate
+		g.mutex.Unlock()
+
+		if gate == nil {
+			return nil
+		}
+
+		if g.ctx == nil {
+			<-gate
+			continue
+		}
+
+		select {
+		case <-gate:
+		case <-g.ctx.Done():
+			return g.ctx.Err()
 		}
 	}
 }
 
-func (g *Group[T]) appendResults(res []T) {
+// Started returns a channel that is closed once every task submitted so far
+// has started running. It is useful to coordinate phases that depend on all
+// the tasks being live, for example when the group is paused or its tasks
+// wait for a free slot. If no task is waiting to start, the returned channel
+// is already closed.
+func (g *Group[T]) Started() <-chan struct{} {
+	g.checkNil()
+
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
-	g.results = append(g.results, res...)
+
+	ch := make(chan struct{})
+	if g.pending == 0 {
+		close(ch)
+		return ch
+	}
+
+	g.starters = append(g.starters, ch)
+
+	return ch
 }
 
-// Wait blocks until all function calls from the Go method have returned, then
-// returns the concatenated results and a multiError containing all errors that
-// are below the threshold.
-func (g *Group[T]) Wait() ([]T, errorWithUnwrap) {
-	g.wg.Wait()
+// addTask registers a new task that is pending to start. The caller must
+// have counted it in the wait group. It panics if Wait has returned since
+// the group was created or reset.
+func (g *Group[T]) addTask(label string) *task[T] {
 	g.mutex.Lock()
 	defer g.mutex.Unlock()
 
-	if g.cancel != nil {
-		g.cancel()
+	if g.waited {
+		g.wg.Done()
+		panic(errWaited)
+	}
+
+	t := &task[T]{index: len(g.tasks), label: label, done: make(chan struct{})}
+	if g.rich {
+		t.stack = debug.Stack()
+	}
+
+	g.tasks = append(g.tasks, t)
+	g.inFlight++
+	g.reportInFlight()
+	g.pending++
+
+	return t
+}
+
+// markStarted marks a pending task as started, notifies Started callers
+// once no task is left waiting, and calls the task start hook.
+func (g *Group[T]) markStarted(t *task[T]) {
+	g.mutex.Lock()
+	g.leavePending()
+	g.running++
+	if g.running > g.peak {
+		g.peak = g.running
+	}
+	onTaskStart := g.onTaskStart
+	g.mutex.Unlock()
+
+	if onTaskStart != nil {
+		onTaskStart(t.index)
+	}
+}
+
+// skip marks a pending task that will not run as done with err, without
+// collecting err.
+func (g *Group[T]) skip(t *task[T], err error) {
+	g.mutex.Lock()
+	g.leavePending()
+	t.processed = true
+	t.canceled = true
+	t.skipped = true
+	g.mutex.Unlock()
+
+	t.finish(nil, err)
+}
+
+// skipSubmitted registers a task that is not run because the group context
+// is already canceled, and marks it as done with err, like skip. Nothing is
+// registered once the group is aborted.
+func (g *Group[T]) skipSubmitted(err error) {
+	g.mutex.Lock()
+	aborted := g.aborted
+	g.mutex.Unlock()
+
+	if aborted {
+		return
+	}
+
+	g.wg.Add(1)
+	t := g.addTask("")
+	g.skip(t, err)
+	g.done(0)
+}
+
+// leavePending counts a pending task out, and notifies Started callers once
+// no task is left waiting. It must be called with the mutex held.
+func (g *Group[T]) leavePending() {
+	g.pending--
+	if g.pending == 0 {
+		for _, ch := range g.starters {
+			close(ch)
+		}
+
+		g.starters = nil
+	}
+}
+
+// processResult collects the outcome of a task. The errors and results are
+// handled in a single critical section, so the group state transitions
+// atomically. It reports whether the results must be kept with the task.
+func (g *Group[T]) processResult(t *task[T], res []T, err error) bool {
+	g.mutex.Lock()
+	t.processed = true
+	g.running--
+
+	if err == nil && len(res) == 0 && !t.emitted {
+		err = g.emptyErr
+	}
+
+	decision := g.classify(err)
+	if decision == ErrorIgnore {
+		err = nil
+	}
+
+	g.adapt(t, err)
+	g.trackFailures(err)
+	if err != nil {
+		t.canceled = errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+		t.failed = !t.canceled
+	}
+
+	var reached bool
+	if err != nil {
+		reached = g.handleErrors(err)
+
+		if decision == ErrorFatal {
+			g.cancelCauseLocked(err)
+		}
+
+		if g.discardOnError {
+			res = nil
+		}
+	}
+
+	from := len(g.results)
+	streamed := g.appendResults(t, res)
+	t.from, t.to = from, len(g.results)
+	g.completed++
+	if err != nil {
+		g.failed++
+	}
+	reached = g.checkErrorRate() || reached
+	completed, errCount, onComplete := g.completed, g.errCount, g.onComplete
+	onTaskDone, onThreshold, forward := g.onTaskDone, g.onThreshold, g.forward
+	keep := g.keepsTaskResults()
+	g.mutex.Unlock()
+
+	g.send(streamed)
+
+	if err != nil && forward != nil {
+		forward(err)
+	}
+
+	if reached && onThreshold != nil {
+		onThreshold()
+	}
+
+	if onTaskDone != nil {
+		onTaskDone(t.index, len(res), err)
+	}
+
+	if onComplete != nil {
+		onComplete(completed, errCount)
+	}
+
+	return keep
+}
+
+// keepsTaskResults reports whether the results of each task are kept with
+// it, for WaitTask and WaitDetailed. It must be called with the mutex held.
+func (g *Group[T]) keepsTaskResults() bool {
+	return !g.noTaskResults && !g.noCollect && g.sink == nil && g.stream == nil
+}
+
+// SetOnComplete sets a callback that is invoked each time a task returns,
+// after its results and error are collected, with the number of tasks
+// completed so far and the number of errors returned by tasks so far.
+// The total number of tasks is not reported, since more can be submitted
+// until Wait is called.
+// The callback is invoked without holding the group mutex, from the
+// goroutines of the tasks, so it may be called concurrently and must be
+// safe for concurrent use. Calls may be delivered out of order.
+func (g *Group[T]) SetOnComplete(onComplete func(completed, errors int)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.onComplete = onComplete
+}
+
+// addError records an error that does not come from a task function.
+func (g *Group[T]) addError(err error) {
+	g.mutex.Lock()
+	reached := g.handleErrors(err)
+	onThreshold := g.onThreshold
+	g.mutex.Unlock()
+
+	if reached && onThreshold != nil {
+		onThreshold()
+	}
+}
+
+// handleErrors records the error of a task, and cancels the group context
+// once the threshold is reached. It reports whether this error reached the
+// threshold. It must be called with the mutex held.
+func (g *Group[T]) handleErrors(err error) bool {
+	if g.closed {
+		return false
+	}
+
+	g.errCount++
+
+	if g.threshold != 0 && g.countedErrors() >= g.threshold && !g.fullErrors {
+		g.dropped++
+		return false
+	}
+
+	// The errors of the tasks stopped by a reached quorum are expected.
+	if g.quorum && g.collected >= g.maxResults {
+		g.dropped++
+		return false
+	}
+
+	g.occurrences++
+	if i, ok := g.duplicateError(err); ok {
+		// Duplicates of errors left out of the sample are only counted.
+		if i >= 0 {
+			g.errCounts[i]++
+		}
+	} else if g.sampleSize > 0 && len(g.errs) >= g.sampleSize {
+		g.unsampled++
+		if g.dedupeErrs {
+			g.errIndex[err.Error()] = -1
+		}
+	} else {
+		g.appendError(err)
+	}
+
+	if g.threshold == 0 || g.countedErrors() != g.threshold || g.triggered != 0 {
+		return false
+	}
+
+	g.triggered = len(g.errs)
+	g.record(EventThresholdReached, nil, nil)
+	g.cancelCauseLocked(g.thresholdCause())
+
+	return true
+}
+
+// thresholdCause returns the cause of the cancellation of a group whose
+// threshold is reached: ErrThresholdReached, wrapping the errors collected so
+// far. It must be called with the mutex held.
+func (g *Group[T]) thresholdCause() error {
+	joined := Join(g.errs...)
+	if joined == nil {
+		return ErrThresholdReached
+	}
+
+	return fmt.Errorf("%w: %w", ErrThresholdReached, joined)
+}
+
+// duplicateError returns the index of the collected error with the same
+// message as err, if errors are deduplicated. It must be called with the
+// mutex held.
+func (g *Group[T]) duplicateError(err error) (int, bool) {
+	if !g.dedupeErrs {
+		return 0, false
+	}
+
+	i, ok := g.errIndex[err.Error()]
+	return i, ok
+}
+
+// appendError adds err to the collected errors. It must be called with the
+// mutex held.
+func (g *Group[T]) appendError(err error) {
+	if g.dedupeErrs {
+		if g.errIndex == nil {
+			g.errIndex = make(map[string]int)
+		}
+		g.errIndex[err.Error()] = len(g.errs)
+		g.errCounts = append(g.errCounts, 1)
+	}
+
+	g.errs = append(g.errs, err)
+	g.record(EventError, nil, err)
+}
+
+// countedErrors returns the number of errors that count toward the
+// threshold. It must be called with the mutex held.
+func (g *Group[T]) countedErrors() int {
+	if g.dedupeErrs && !g.countUnique {
+		return g.occurrences
+	}
+
+	return len(g.errs) + g.unsampled
+}
+
+// appendResults collects the results of task t. It returns the results that
+// must be sent to the stream, if the group is streaming. It must be called
+// with the mutex held.
+func (g *Group[T]) appendResults(t *task[T], res []T) []T {
+	if g.closed || g.discarding() {
+		return nil
+	}
+
+	res = g.filterResults(res)
+	res = g.dedup(res)
+	res = g.limitResults(res)
+	if g.sharded && g.stream == nil && !g.noCollect {
+		t.kept = res
+		g.shardLen += len(res)
+	} else if g.stream == nil && !g.noCollect {
+		if g.results == nil && g.capacity > len(res) {
+			g.results = make([]T, 0, g.capacity)
+		}
+		g.results = append(g.results, res...)
+		g.dropOldest()
+	}
+
+	if g.sink != nil && len(res) > 0 {
+		g.sink(res)
+	}
+
+	if len(res) > 0 {
+		g.record(EventResults, res, nil)
+	}
+
+	if g.cost != nil && !g.budgetExceeded {
+		g.spent += g.cost(res)
+		if g.spent > g.budget {
+			g.budgetExceeded = true
+			g.appendError(ErrBudgetExceeded)
+			g.cancelLocked()
+		}
+	}
+
+	if g.score != nil && !g.targetReached && g.score(g.results) >= g.target {
+		g.targetReached = true
+		g.cancelLocked()
+	}
+
+	if g.stream == nil && len(g.subscribers) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+// discarding reports whether the results are discarded and nothing else
+// observes them, so they need not be processed at all. It must be called
+// with the mutex held.
+func (g *Group[T]) discarding() bool {
+	return g.noCollect && g.sink == nil && g.stream == nil && len(g.subscribers) == 0 &&
+		!g.timed && g.maxResults == 0 && g.cost == nil && g.score == nil
+}
+
+// filterResults applies the result filter, if any, to the results, without
+// modifying the slice returned by the task. It must be called with the mutex
+// held.
+func (g *Group[T]) filterResults(res []T) []T {
+	if g.filter == nil || len(res) == 0 {
+		return res
+	}
+
+	kept := make([]T, 0, len(res))
+	for _, v := range res {
+		if v, ok := g.filter(v); ok {
+			kept = append(kept, v)
+		}
+	}
+
+	return kept
+}
+
+// SetResultFilter sets a function applied to each result as it is
+// collected, before the other options such as SetMaxResults and the sinks
+// see it: the result is replaced by the returned value, or dropped if the
+// returned bool is false, for example to drop zero values or normalize
+// entries without post-processing the results of Wait. Dropping every
+// result of a task does not make it empty for SetEmptyResultAsError.
+// The filter is called with the group mutex held, so calls are serialized,
+// and it must not call methods of the group. A nil filter, the default,
+// keeps the results as is.
+func (g *Group[T]) SetResultFilter(filter func(T) (T, bool)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.filter = filter
+}
+
+// limitResults truncates the results to the room left under the maximum
+// number of results, and cancels the group context once it is reached.
+// It must be called with the mutex held.
+func (g *Group[T]) limitResults(res []T) []T {
+	if g.maxResults == 0 {
+		return res
+	}
+
+	if g.keepsNewest() {
+		return res
+	}
+
+	room := g.maxResults - g.collected
+	if len(res) > room {
+		res = res[:room]
+	}
+
+	g.collected += len(res)
+	if g.collected == g.maxResults && g.overflow == OverflowCancel {
+		g.cancelLocked()
+	}
+
+	return res
+}
+
+// SetMaxResults limits the number of collected results to max. Once max
+// results are collected, the group context is canceled to stop the
+// remaining tasks, and any further results are discarded, including the
+// ones of the task that overshoots the limit.
+// It can be combined with an error threshold: whichever is reached first
+// cancels the context, and Wait returns both the collected results and the
+// collected errors. A max of 0, the default, disables the limit.
+func (g *Group[T]) SetMaxResults(max int) {
+	g.checkNil()
+
+	if max < 0 {
+		panic("max results must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.maxResults = max
+	g.overflow = OverflowCancel
+}
+
+// SetCapacity sets the expected number of results, so the slice of
+// collected results is allocated once instead of growing as results are
+// appended. Exceeding it is allowed and grows the slice as usual. The
+// capacity is kept by Reset, and applies to each round.
+func (g *Group[T]) SetCapacity(n int) {
+	g.checkNil()
+
+	if n < 0 {
+		panic("capacity must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.capacity = n
+}
+
+// SetShardedResults sets whether the results of each task are kept with the
+// task instead of being appended to a shared slice, and concatenated once by
+// Wait, in submission order. Each task still takes the group mutex to
+// record its outcome, but no longer copies its results while holding it,
+// which shortens the critical section of tasks returning many results.
+// The copy is paid once by Wait instead. The results are still filtered by
+// SetDedupKey and SetMaxResults as tasks return, but SetQualityTarget
+// scores no results, and SetCapacity has no effect.
+// It must be called before any task is started.
+func (g *Group[T]) SetShardedResults(sharded bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.sharded = sharded
+}
+
+// mergeShards concatenates the results kept by the tasks, in submission
+// order. It must be called with the mutex held.
+func (g *Group[T]) mergeShards() []T {
+	if g.shardLen == 0 {
+		return nil
+	}
+
+	results := make([]T, 0, g.shardLen)
+	for _, t := range g.tasks {
+		results = append(results, t.kept...)
+	}
+
+	return results
+}
+
+// SetOrderedResults sets whether Wait returns the results in the order the
+// tasks were submitted, instead of the order they completed in, so the
+// output is stable across runs. The results of each task stay in the order
+// the task returned them. Options that limit the results, such as
+// SetMaxResults, still apply in completion order.
+func (g *Group[T]) SetOrderedResults(ordered bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.ordered = ordered
+}
+
+// collectedResults returns the collected results, in submission order if
+// SetOrderedResults is enabled. It must be called with the mutex held.
+func (g *Group[T]) collectedResults() []T {
+	if g.sharded {
+		return g.mergeShards()
+	}
+
+	if !g.ordered || g.results == nil {
+		return g.results
+	}
+
+	results := make([]T, 0, len(g.results))
+	for _, t := range g.tasks {
+		results = append(results, g.results[t.from:t.to]...)
+	}
+
+	return results
+}
+
+// SetKeepResultsOnError sets whether the results returned by a task along
+// with an error are collected. They are by default; disabling it makes a
+// failed task contribute no results at all.
+func (g *Group[T]) SetKeepResultsOnError(keep bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.discardOnError = !keep
+}
+
+// SetDiscardResults sets whether the results of the tasks are discarded
+// instead of collected, for groups that only aggregate errors and rely on
+// the threshold. Wait then returns no results, and WaitTask and WaitDetailed
+// report no results either, only the errors and durations of the tasks, so
+// the results are not retained at all. The results are still passed to the
+// streams, subscribers and the other options that observe them.
+func (g *Group[T]) SetDiscardResults(discard bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.noCollect = discard
+}
+
+// SetEmptyResultAsError makes the group record err for every task that
+// returns no results and no error, so tasks that succeed without producing
+// anything are flagged. The error counts toward the threshold.
+// Passing a nil error disables it.
+func (g *Group[T]) SetEmptyResultAsError(err error) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.emptyErr = err
+}
+
+// SetRichErrors enables or disables wrapping each task error in a *TaskError
+// that holds the task submission index, the stack of the Go call that
+// submitted it and how long it ran, for debugging complex fan-outs.
+// Capturing the submission stack is expensive, so it is disabled by default.
+// SetRichErrors must be called before any task is submitted.
+func (g *Group[T]) SetRichErrors(enabled bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.rich = enabled
+}
+
+// SetBudget makes each task's results consume cost(res) units of a budget of
+// max units, which can be rows, tokens, API credits or any other unit.
+// Once the total cost exceeds max, ErrBudgetExceeded is added to the errors
+// returned by Wait, regardless of the threshold, and the group context is
+// canceled. The results of the task that exceeded the budget, and of tasks
+// already running at that point, are still collected.
+func (g *Group[T]) SetBudget(cost func([]T) int, max int) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.cost = cost
+	g.budget = max
+}
+
+// SetQualityTarget makes the group cancel its context once score, evaluated
+// against all the results collected so far, reaches target. It generalizes
+// result limits to a domain-specific quality metric, for fan-outs that should
+// stop once the results are good enough.
+// The score is evaluated under the group mutex after each task's results are
+// appended, so it should be fast. Tasks that are already running when the
+// target is reached may still add their results, so the final results can
+// overshoot the target.
+// SetQualityTarget has no effect on a Group without a context.
+func (g *Group[T]) SetQualityTarget(score func([]T) float64, target float64) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.score = score
+	g.target = target
+}
+
+// SetSkipOnCancel sets whether tasks that have not started when the group
+// context is canceled, by the threshold, Cancel or the parent context, are
+// skipped instead of run, so their functions do not need to check the
+// context themselves. A skipped task collects no results and records no
+// error; WaitTask and WaitDetailed report ErrTaskSkipped for it, and Stats
+// counts it as canceled. Tasks are run regardless by default.
+func (g *Group[T]) SetSkipOnCancel(skip bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.skipOnCancel = skip
+}
+
+// SetCancelOnWait sets whether Wait cancels the group context, which it does
+// by default. Disabling it keeps the context live for work derived from it
+// after Wait; the caller is then responsible for calling Cancel to release
+// the resources associated with the context.
+func (g *Group[T]) SetCancelOnWait(cancel bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.noCancelOnWait = !cancel
+}
+
+// Cancel cancels the group context. It has no effect on a Group without
+// a context, and it is safe to call more than once.
+// Running tasks are not stopped, unless they observe the context.
+func (g *Group[T]) Cancel() {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.cancelLocked()
+}
+
+// Abort stops the group: it cancels the group context, makes further calls
+// to Go and its variants no-ops, and discards the results and errors of the
+// tasks that are still running, so Wait returns what was collected before
+// the abort once they have returned. Reset makes the group usable again.
+func (g *Group[T]) Abort() {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.aborted = true
+	g.closed = true
+	g.cancelLocked()
+}
+
+// cancelLocked cancels the group context. It must be called with the mutex
+// held.
+func (g *Group[T]) cancelLocked() {
+	g.cancelCauseLocked(nil)
+}
+
+// cancelCauseLocked cancels the group context with the given cause, which
+// context.Cause returns. A nil cause makes it context.Canceled. It must be
+// called with the mutex held.
+func (g *Group[T]) cancelCauseLocked(cause error) {
+	if g.cancel == nil || g.canceled {
+		return
+	}
+
+	g.canceled = true
+	g.cancel(cause)
+	g.record(EventCanceled, nil, nil)
+}
+
+// Wait blocks until all function calls from the Go method have returned, then
+// returns the concatenated results and a *MultiError containing all errors that
+// are below the threshold. A Group created with WithFailFast returns the first
+// error instead.
+// Without errors, Wait returns a nil error, never a typed nil *MultiError.
+// Wait can be called several times, and returns the same results and errors
+// each time. Once it has returned, submitting a task panics until Reset is
+// called.
+func (g *Group[T]) Wait() ([]T, error) {
+	return g.WaitWith()
+}
+
+// WaitWith works like Wait, but joins the extra errors, such as a setup
+// failure that happened outside of the group, with the errors of the tasks.
+// Nil errors in extra are ignored, and extra errors do not count toward
+// the threshold.
+func (g *Group[T]) WaitWith(extra ...error) ([]T, error) {
+	g.checkNil()
+
+	complete := g.waitTasks()
+	g.stopCheckpoint()
+	g.closeStream(complete)
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.waited = true
+
+	if !g.noCancelOnWait {
+		g.cancelLocked()
+	}
+
+	return g.collectedResults(), g.err(extra...)
+}
+
+// ResultsLen returns the number of results collected so far. It can be
+// called while tasks are running, and does not wait for them.
+func (g *Group[T]) ResultsLen() int {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.sharded {
+		return g.shardLen
+	}
+
+	return len(g.results)
+}
+
+// ErrorsLen returns the number of errors collected so far, which are the
+// errors that Wait would return. It can be called while tasks are running,
+// and does not wait for them.
+func (g *Group[T]) ErrorsLen() int {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return len(g.errs)
+}
+
+// ErrorCount returns the number of errors returned by the tasks so far,
+// including the ones dropped because the threshold was reached.
+func (g *Group[T]) ErrorCount() int {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return g.errCount
+}
+
+// DroppedErrors returns the number of errors returned by the tasks so far
+// that were dropped because the threshold was reached, and are therefore
+// missing from the error returned by Wait.
+func (g *Group[T]) DroppedErrors() int {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return g.dropped
+}
+
+// SetErrorFormatter sets the function used to format the message of the
+// *MultiError returned by Wait, for example to keep it on a single line for
+// structured logging. By default the messages of the errors are joined with
+// newlines. The formatter does not affect Unwrap, so errors.Is and errors.As
+// keep working.
+func (g *Group[T]) SetErrorFormatter(format func([]error) string) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.errFormat = format
+}
+
+// SetErrorJoiner sets the function used to aggregate the errors returned by
+// Wait instead of the built-in *MultiError, for example errors.Join or a
+// domain-specific error. It is called with the collected errors, in the
+// order they were collected, only when there is at least one; its result,
+// which may be nil, is returned as is, including for fail-fast groups.
+// A nil joiner, the default, restores the *MultiError.
+func (g *Group[T]) SetErrorJoiner(join func([]error) error) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.errJoin = join
+}
+
+// SetDedupeErrors sets whether errors with the same message are collected
+// only once. Two errors are duplicates when their Error() strings are equal;
+// errors.Is is not used, since distinct wrapped errors often share a
+// sentinel. The first error with a given message is kept, and the number of
+// its occurrences is reported by the Counts method of the *MultiError
+// returned by Wait, and rendered by its Error method as "message (xN)".
+// Duplicates still count toward the threshold unless SetCountUniqueErrors
+// is called. It must be called before any task is submitted.
+func (g *Group[T]) SetDedupeErrors(dedupe bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.dedupeErrs = dedupe
+}
+
+// SetErrorSampleSize limits the number of distinct errors kept to n, so a
+// batch where thousands of tasks fail does not produce an unusable error.
+// The errors beyond the sample still count toward the threshold, but are
+// only counted: the Omitted method of the *MultiError returned by Wait
+// reports how many were left out, and its Error method mentions them.
+// Combined with SetDedupeErrors, the sample holds the first n distinct
+// messages, with the number of occurrences of each, and Omitted reports the
+// number of distinct messages left out. A value of 0, the default, keeps
+// every error.
+func (g *Group[T]) SetErrorSampleSize(n int) {
+	g.checkNil()
+
+	if n < 0 {
+		panic("error sample size must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.sampleSize = n
+}
+
+// SetFullErrorCollection sets whether the errors returned once the threshold
+// is reached are still collected, instead of being dropped, so Wait does not
+// under-report failures. The errors up to the one that reached the threshold
+// are returned by the Triggering method of the *MultiError returned by Wait,
+// and all of them by its Errors method.
+func (g *Group[T]) SetFullErrorCollection(full bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.fullErrors = full
+}
+
+// SetCountUniqueErrors sets whether only distinct errors count toward the
+// threshold of a Group with deduplicated errors, so that a single failure
+// repeated by many tasks does not cancel the group on its own. It has no
+// effect unless SetDedupeErrors is enabled.
+func (g *Group[T]) SetCountUniqueErrors(unique bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.countUnique = unique
+}
+
+// Drain releases the results and errors held by the group, so they can be
+// garbage collected while the configured group is kept for later use.
+// The slices returned by Wait are not affected. Drain must be called after
+// Wait; calling it while tasks are running discards their results.
+func (g *Group[T]) Drain() {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.results = nil
+	g.errs = nil
+	g.errIndex = nil
+	g.errCounts = nil
+	g.triggered = 0
+	g.tasks = nil
+	g.onceKeys = nil
+	g.shardLen = 0
+}
+
+// WaitContext works like Wait, but returns early if ctx is canceled before
+// all tasks have returned. In that case it cancels the group context, and
+// returns a copy of the results collected so far along with the errors
+// collected so far joined with ctx.Err().
+// Tasks that are still running are not waited for: they keep running in the
+// background and may keep appending to the group internal state, which does
+// not affect the returned results.
+func (g *Group[T]) WaitContext(ctx context.Context) ([]T, error) {
+	g.checkNil()
+
+	return g.waitUntil(ctx.Done(), ctx.Err)
+}
+
+// WaitTimeout works like WaitContext, but returns early once d elapses.
+// In that case the returned errors are joined with ErrWaitIncomplete.
+func (g *Group[T]) WaitTimeout(d time.Duration) ([]T, error) {
+	g.checkNil()
+
+	ctx, cancel := g.withTimeout(context.Background(), d)
+	defer cancel()
+
+	return g.waitUntil(ctx.Done(), func() error {
+		return ErrWaitIncomplete
+	})
+}
+
+// waitUntil works like Wait, but returns early once stop is closed, with
+// the error returned by cause joined with the collected errors.
+func (g *Group[T]) waitUntil(stop <-chan struct{}, cause func() error) ([]T, error) {
+	select {
+	case <-g.allDone():
+		return g.WaitWith()
+	case <-stop:
+	}
+
+	g.stopCheckpoint()
+	g.closeStream(false)
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.waited = true
+
+	if !g.noCancelOnWait {
+		g.cancelLocked()
+	}
+
+	if g.ordered || g.sharded {
+		return g.collectedResults(), g.err(cause())
+	}
+
+	return append([]T(nil), g.results...), g.err(cause())
+}
+
+// allDone returns a channel that is closed once all tasks have returned.
+func (g *Group[T]) allDone() <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	return done
+}
+
+// Reset clears the results and errors of the group so it can be reused for
+// another round of tasks after Wait, and returns the new group context.
+// A Group created with a context gets a fresh context derived from the
+// original parent context; a Group without a context returns
+// context.Background(). The configuration of the group, such as the
+// threshold and the limit, is kept; a checkpoint set with SetCheckpoint is
+// stopped by Wait and must be set again.
+// Reset panics if any tasks are still running.
+func (g *Group[T]) Reset() context.Context {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.inFlight != 0 {
+		panic(fmt.Errorf("resultgroup: reset while %v tasks are still running", g.inFlight))
+	}
+
+	g.errs = nil
+	g.errCount = 0
+	g.dropped = 0
+	g.errIndex = nil
+	g.errCounts = nil
+	g.occurrences = 0
+	g.unsampled = 0
+	g.triggered = 0
+	g.results = nil
+	g.tasks = nil
+	g.onceKeys = nil
+	g.shardLen = 0
+	g.completed = 0
+	g.failed = 0
+	g.peak = 0
+	g.rateReached = false
+	g.consecutive = 0
+	g.circuitOpen = false
+	g.collected = 0
+	g.closed = false
+	g.graceExpired = false
+	g.aborted = false
+	g.waited = false
+	g.stream = nil
+	g.subscribers = nil
+	g.streamClosed = false
+	g.targetReached = false
+	g.spent = 0
+	g.budgetExceeded = false
+	g.seen = nil
+	g.seenOrder = nil
+	g.timeline = nil
+	g.checkpointErrs = 0
+
+	if g.parent != nil {
+		g.initContext(g.parent)
+		g.canceled = false
+	}
+
+	return g.context()
+}
+
+// err returns the aggregated error of the group joined with the extra errors.
+// It must be called with the mutex held.
+func (g *Group[T]) err(extra ...error) error {
+	if g.circuitOpen {
+		extra = append([]error{ErrCircuitOpen}, extra...)
+	}
+
+	errs := g.errs
+	if len(extra) > 0 {
+		errs = append([]error(nil), g.errs...)
+		for _, err := range extra {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	if g.errJoin != nil {
+		return g.errJoin(append([]error(nil), errs...))
 	}
 
-	if len(g.errs) == 0 {
-		return g.results, nil
+	if g.failFast && len(errs) == 1 {
+		return errs[0]
 	}
 
-	return g.results, &multiError{errs: g.errs}
+	return &MultiError{errs: errs, counts: g.errCounts, triggered: g.triggered, omitted: g.unsampled, format: g.errFormat}
 }
@@ -3,6 +3,11 @@ package resultgroup
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,6 +20,16 @@ var (
 	err3 = errors.New("Error 3")
 )
 
+// unwrapErrors returns the errors wrapped by err.
+func unwrapErrors(err error) []error {
+	u, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return nil
+	}
+
+	return u.Unwrap()
+}
+
 func TestGroup(t *testing.T) {
 	t.Parallel()
 
@@ -22,6 +37,58 @@ func TestGroup(t *testing.T) {
 	t.Run("with errors", testGroupWithErrors)
 	t.Run("max errors reached", testGroupMaxErrorsReached)
 	t.Run("no error limit", testGroupNoErrorLimit)
+	t.Run("pause and resume", testGroupPauseResume)
+	t.Run("pause canceled", testGroupPauseCanceled)
+	t.Run("fail fast", testGroupFailFast)
+	t.Run("wait with extra errors", testGroupWaitWith)
+	t.Run("no cancel on wait", testGroupNoCancelOnWait)
+	t.Run("nil group", testGroupNil)
+	t.Run("quality target", testGroupQualityTarget)
+	t.Run("drain", testGroupDrain)
+	t.Run("budget", testGroupBudget)
+	t.Run("empty result as error", testGroupEmptyResultAsError)
+	t.Run("error ratio", testGroupErrorRatio)
+	t.Run("started", testGroupStarted)
+	t.Run("limit", testGroupLimit)
+	t.Run("limit with cancel", testGroupLimitCancel)
+	t.Run("try go", testGroupTryGo)
+	t.Run("go with context", testGroupGoCtx)
+	t.Run("go with canceled context", testGroupGoCtxCanceled)
+	t.Run("ordered results", testGroupOrderedResults)
+	t.Run("go with timeout", testGroupGoWithTimeout)
+	t.Run("go with timeout parent deadline", testGroupGoWithTimeoutParentDeadline)
+	t.Run("results target", testGroupResultsTarget)
+	t.Run("capacity", testGroupCapacity)
+	t.Run("sharded results", testGroupShardedResults)
+	t.Run("error rate threshold", testGroupErrorRateThreshold)
+	t.Run("full error collection", testGroupFullErrorCollection)
+	t.Run("abort", testGroupAbort)
+	t.Run("go once", testGroupGoOnce)
+	t.Run("go priority", testGroupGoPriority)
+	t.Run("result filter", testGroupResultFilter)
+	t.Run("go after wait", testGroupGoAfterWait)
+	t.Run("cancel cause", testGroupCancelCause)
+	t.Run("skip on cancel", testGroupSkipOnCancel)
+	t.Run("with context", testGroupWithContext)
+	t.Run("multi error", testGroupMultiError)
+	t.Run("wait with context", testGroupWaitContext)
+	t.Run("wait timeout", testGroupWaitTimeout)
+	t.Run("task timeout", testGroupTaskTimeout)
+	t.Run("error count", testGroupErrorCount)
+	t.Run("reset", testGroupReset)
+	t.Run("go one", testGroupGoOne)
+	t.Run("keep results on error", testGroupKeepResultsOnError)
+	t.Run("on complete", testGroupOnComplete)
+	t.Run("error formatter", testGroupErrorFormatter)
+	t.Run("error joiner", testGroupErrorJoiner)
+	t.Run("concurrent errors", testGroupConcurrentErrors)
+	t.Run("live counts", testGroupLiveCounts)
+	t.Run("max results", testGroupMaxResults)
+	t.Run("weighted tasks", testGroupGoWeight)
+	t.Run("dedupe errors", testGroupDedupeErrors)
+	t.Run("error sample size", testGroupErrorSampleSize)
+	t.Run("count unique errors", testGroupCountUniqueErrors)
+	t.Run("discard results", testGroupDiscardResults)
 }
 
 // testGroupNoErrors checks if the Group works correctly when there are no errors.
@@ -65,8 +132,10 @@ func testGroupWithErrors(t *testing.T) {
 		return []int{2}, nil
 	})
 
-	results, err := group.Wait()
+	results, waitErr := group.Wait()
+	err, _ := waitErr.(*MultiError)
 
+	assert.IsType(t, &MultiError{}, waitErr, "Expected a *MultiError, got: %T", waitErr)
 	assert.NotNil(t, err, "Expected an error, got nil")
 	assert.Len(t, err.Unwrap(), 2, "Expected 2 errors, got: %d", len(err.Unwrap()))
 	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
@@ -114,8 +183,10 @@ func testGroupMaxErrorsReached(t *testing.T) {
 		}
 	})
 
-	results, err := group.Wait()
+	results, waitErr := group.Wait()
+	err, _ := waitErr.(*MultiError)
 
+	assert.IsType(t, &MultiError{}, waitErr, "Expected a *MultiError, got: %T", waitErr)
 	assert.NotNil(t, err, "Expected an error, got nil")
 	assert.Len(t, err.Unwrap(), 2, "Expected 2 errors, got: %d", len(err.Unwrap()))
 	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
@@ -150,3 +221,1635 @@ func testGroupNoErrorLimit(t *testing.T) {
 	assert.NotNil(t, err, "Expected an error, got nil")
 	assert.Len(t, results, 2, "Expected 2 results, got: %d", len(results))
 }
+
+// testGroupPauseResume checks that no new tasks start while the Group is paused.
+func testGroupPauseResume(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	var started atomic.Int32
+
+	group.Pause()
+
+	for i := 0; i < 3; i++ {
+		i := i
+
+		group.Go(func() ([]int, error) {
+			started.Add(1)
+			return []int{i}, nil
+		})
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(0), started.Load(), "Expected no tasks to start while paused, got: %d", started.Load())
+
+	group.Resume()
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, int32(3), started.Load(), "Expected 3 tasks to start after resume, got: %d", started.Load())
+	assert.Len(t, results, 3, "Expected 3 results, got: %d", len(results))
+}
+
+// testGroupPauseCanceled checks that the tasks held back by a pause are skipped with the context error once the context is canceled.
+func testGroupPauseCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.Pause()
+
+	var ran atomic.Bool
+	group.Go(func() ([]int, error) {
+		ran.Store(true)
+		return []int{1}, nil
+	})
+
+	group.Cancel()
+	_, taskErr := group.WaitTask(0)
+	results, err := group.Wait()
+
+	assert.False(t, ran.Load(), "Expected the paused task not to run once the context is canceled")
+	assert.ErrorIs(t, taskErr, context.Canceled, "Expected error to be: %v, got: %v", context.Canceled, taskErr)
+	assert.NoError(t, err, "Expected the skipped task to record no error, got: %v", err)
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+}
+
+// testGroupFailFast checks that a fail-fast Group cancels on the first error and returns it unwrapped.
+func testGroupFailFast(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithFailFast[int](context.Background())
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+			return []int{2}, nil
+		}
+	})
+
+	results, err := group.Wait()
+
+	assert.Equal(t, err1, err, "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// testGroupWaitWith checks that extra errors are joined with the errors of the tasks.
+func testGroupWaitWith(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	errSetup := errors.New("setup error")
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	results, err := group.WaitWith(nil, errSetup)
+
+	assert.NotNil(t, err, "Expected an error, got nil")
+	assert.Len(t, unwrapErrors(err), 2, "Expected 2 errors, got: %d", len(unwrapErrors(err)))
+	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
+	assert.True(t, errors.Is(err, errSetup), "Expected error to be: %v, got: %v", errSetup, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// testGroupNoCancelOnWait checks that the context stays live after Wait until Cancel is called.
+func testGroupNoCancelOnWait(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetCancelOnWait(false)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	_, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Nil(t, ctx.Err(), "Expected context to be live after Wait, got: %v", ctx.Err())
+
+	group.Cancel()
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "Expected context to be canceled, got: %v", ctx.Err())
+}
+
+// testGroupNil checks that methods called on a nil Group panic with a clear message.
+func testGroupNil(t *testing.T) {
+	t.Parallel()
+
+	var group *Group[int]
+
+	methods := map[string]func(){
+		"Go":       func() { group.Go(func() ([]int, error) { return nil, nil }) },
+		"Wait":     func() { _, _ = group.Wait() },
+		"WaitWith": func() { _, _ = group.WaitWith(err1) },
+		"Pause":    func() { group.Pause() },
+		"Resume":   func() { group.Resume() },
+		"Cancel":   func() { group.Cancel() },
+	}
+
+	for name, method := range methods {
+		assert.PanicsWithValue(t, errNilGroup, method, "Expected %s to panic with: %s", name, errNilGroup)
+	}
+}
+
+// testGroupQualityTarget checks that the Group cancels the context once the results are good enough.
+func testGroupQualityTarget(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetQualityTarget(func(results []int) float64 {
+		sum := 0
+		for _, r := range results {
+			sum += r
+		}
+
+		return float64(sum)
+	}, 5)
+
+	for i := 1; i <= 3; i++ {
+		i := i
+
+		group.Go(func() ([]int, error) {
+			return []int{i}, nil
+		})
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Expected context to be canceled once the target is reached")
+	}
+
+	group.Go(func() ([]int, error) {
+		if ctx.Err() != nil {
+			return nil, nil
+		}
+
+		return []int{4}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3}, results)
+}
+
+// testGroupDrain checks that Drain releases the results and errors held by the Group.
+func testGroupDrain(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, err1
+	})
+
+	results, err := group.Wait()
+	group.Drain()
+
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
+	assert.Nil(t, group.results, "Expected results to be released, got: %v", group.results)
+	assert.Nil(t, group.errs, "Expected errors to be released, got: %v", group.errs)
+}
+
+// testGroupBudget checks that the Group cancels the context once the results exceed the budget.
+func testGroupBudget(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[string](context.Background(), 1)
+	group.SetBudget(func(results []string) int {
+		tokens := 0
+		for _, r := range results {
+			tokens += len(strings.Fields(r))
+		}
+
+		return tokens
+	}, 5)
+
+	group.Go(func() ([]string, error) {
+		return []string{"one two three"}, nil
+	})
+
+	group.Go(func() ([]string, error) {
+		return []string{"four five", "six"}, nil
+	})
+
+	group.Go(func() ([]string, error) {
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-time.After(time.Second):
+			return []string{"seven"}, nil
+		}
+	})
+
+	results, err := group.Wait()
+
+	assert.True(t, errors.Is(err, ErrBudgetExceeded), "Expected error to be: %v, got: %v", ErrBudgetExceeded, err)
+	assert.Len(t, results, 3, "Expected 3 results, got: %d", len(results))
+}
+
+// testGroupEmptyResultAsError checks that a task returning no results and no error is recorded as an error.
+func testGroupEmptyResultAsError(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	errEmpty := errors.New("empty result")
+	group.SetEmptyResultAsError(errEmpty)
+
+	group.Go(func() ([]int, error) {
+		return nil, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	results, err := group.Wait()
+
+	assert.Len(t, unwrapErrors(err), 2, "Expected 2 errors, got: %d", len(unwrapErrors(err)))
+	assert.True(t, errors.Is(err, errEmpty), "Expected error to be: %v, got: %v", errEmpty, err)
+	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// testGroupErrorRatio checks that the threshold is computed from the ratio of expected tasks.
+func testGroupErrorRatio(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorRatio[int](context.Background(), 0.1, 20)
+
+	assert.Equal(t, 2, group.threshold, "Expected threshold to be 2, got: %d", group.threshold)
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	results, err := group.Wait()
+
+	assert.Len(t, unwrapErrors(err), 2, "Expected 2 errors, got: %d", len(unwrapErrors(err)))
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+
+	assert.Panics(t, func() { WithErrorRatio[int](context.Background(), 1.5, 20) }, "Expected a ratio above 1 to panic")
+	assert.Panics(t, func() { WithErrorRatio[int](context.Background(), 0.1, 0) }, "Expected no expected tasks to panic")
+}
+
+// testGroupStarted checks that Started is closed once all submitted tasks have started.
+func testGroupStarted(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	group.Pause()
+
+	for i := 0; i < 3; i++ {
+		i := i
+
+		group.Go(func() ([]int, error) {
+			return []int{i}, nil
+		})
+	}
+
+	started := group.Started()
+
+	select {
+	case <-started:
+		t.Fatal("Expected Started not to be closed while the tasks are paused")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	group.Resume()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Started to be closed after resume")
+	}
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, results, 3, "Expected 3 results, got: %d", len(results))
+
+	select {
+	case <-group.Started():
+	default:
+		t.Fatal("Expected Started to be closed when no task is pending")
+	}
+}
+
+// testGroupLimit checks that the Group runs at most the limit of tasks at the same time.
+func testGroupLimit(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(2)
+
+	var running, maxRunning atomic.Int32
+
+	for i := 0; i < 6; i++ {
+		i := i
+
+		group.Go(func() ([]int, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+
+			return []int{i}, nil
+		})
+	}
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, results, 6, "Expected 6 results, got: %d", len(results))
+	assert.LessOrEqual(t, maxRunning.Load(), int32(2), "Expected at most 2 running tasks, got: %d", maxRunning.Load())
+	assert.Panics(t, func() {
+		group.Go(func() ([]int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return nil, nil
+		})
+		group.SetLimit(1)
+	}, "Expected SetLimit to panic while tasks are running")
+}
+
+// testGroupLimitCancel checks that Go does not block forever on the limit once the context is canceled.
+func testGroupLimitCancel(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetLimit(1)
+
+	group.Go(func() ([]int, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		group.Cancel()
+	}()
+
+	submitted := make(chan struct{})
+	go func() {
+		defer close(submitted)
+
+		group.Go(func() ([]int, error) {
+			return []int{1}, nil
+		})
+	}()
+
+	select {
+	case <-submitted:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Go to return once the context is canceled")
+	}
+
+	_, err := group.Wait()
+
+	assert.True(t, errors.Is(err, context.Canceled), "Expected error to be: %v, got: %v", context.Canceled, err)
+}
+
+// testGroupTryGo checks that TryGo only runs a task when a slot is available under the limit.
+func testGroupTryGo(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	release := make(chan struct{})
+
+	assert.True(t, group.TryGo(func() ([]int, error) {
+		return []int{0}, nil
+	}), "Expected TryGo to run the task without a limit")
+
+	_, _ = group.Wait()
+	group.Reset()
+	group.SetLimit(1)
+
+	assert.True(t, group.TryGo(func() ([]int, error) {
+		<-release
+		return []int{1}, nil
+	}), "Expected TryGo to run the task while a slot is available")
+
+	assert.False(t, group.TryGo(func() ([]int, error) {
+		return []int{2}, nil
+	}), "Expected TryGo not to run the task while the limit is reached")
+
+	close(release)
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// testGroupGoCtx checks that GoCtx passes the group context to the tasks.
+func testGroupGoCtx(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+
+	group.GoCtx(func(ctx context.Context) ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, err1
+	})
+
+	group.GoCtx(func(ctx context.Context) ([]int, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+			return []int{1}, nil
+		}
+	})
+
+	results, err := group.Wait()
+
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+
+	var zero Group[int]
+
+	zero.GoCtx(func(ctx context.Context) ([]int, error) {
+		return []int{2}, ctx.Err()
+	})
+
+	results, err = zero.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{2}, results, "Expected results to be: %v, got: %v", []int{2}, results)
+}
+
+// testGroupWithContext checks that WithContext cancels on the first error and returns it unwrapped, like errgroup.
+func testGroupWithContext(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithContext[int](context.Background())
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	<-ctx.Done()
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, err2
+	})
+
+	results, err := group.Wait()
+
+	assert.Equal(t, err1, err, "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// testGroupMultiError checks that Wait returns a *MultiError with errors, and an untyped nil without.
+func testGroupMultiError(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, err := group.Wait()
+
+	var me *MultiError
+
+	assert.True(t, errors.As(err, &me), "Expected error to be a MultiError, got: %v", err)
+	assert.ElementsMatch(t, []error{err1, err2}, me.Errors(), "Expected errors to be: %v, got: %v", []error{err1, err2}, me.Errors())
+
+	var empty Group[int]
+
+	empty.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	_, err = empty.Wait()
+
+	assert.True(t, err == nil, "Expected an untyped nil error, got: %#v", err)
+}
+
+// testGroupWaitContext checks that WaitContext returns the partial results once its context is canceled.
+func testGroupWaitContext(t *testing.T) {
+	t.Parallel()
+	group, groupCtx := WithErrorsThreshold[int](context.Background(), 3)
+	release := make(chan struct{})
+	defer close(release)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{2}, nil
+	})
+
+	_, _ = group.WaitTask(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	results, err := group.WaitContext(ctx)
+
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "Expected error to be: %v, got: %v", context.DeadlineExceeded, err)
+	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.ErrorIs(t, groupCtx.Err(), context.Canceled, "Expected the group context to be canceled, got: %v", groupCtx.Err())
+}
+
+// testGroupWaitTimeout checks that WaitTimeout returns the partial results with ErrWaitIncomplete, or everything in time.
+func testGroupWaitTimeout(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	release := make(chan struct{})
+	defer close(release)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{2}, nil
+	})
+
+	_, _ = group.WaitTask(0)
+
+	results, err := group.WaitTimeout(10 * time.Millisecond)
+
+	assert.ErrorIs(t, err, ErrWaitIncomplete, "Expected error to be: %v, got: %v", ErrWaitIncomplete, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+
+	complete := Group[int]{}
+	complete.Go(func() ([]int, error) {
+		return []int{3}, nil
+	})
+
+	results, err = complete.WaitTimeout(time.Second)
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{3}, results, "Expected results to be: %v, got: %v", []int{3}, results)
+}
+
+// testGroupTaskTimeout checks that each task gets its own timeout without canceling the group context.
+func testGroupTaskTimeout(t *testing.T) {
+	t.Parallel()
+	group, groupCtx := WithErrorsThreshold[int](context.Background(), 2)
+	group.SetTaskTimeout(10 * time.Millisecond)
+
+	group.GoCtx(func(ctx context.Context) ([]int, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+			return []int{1}, nil
+		}
+	})
+
+	group.GoCtx(func(ctx context.Context) ([]int, error) {
+		_, ok := ctx.Deadline()
+		assert.True(t, ok, "Expected the task context to have a deadline")
+		return []int{2}, nil
+	})
+
+	_, _ = group.WaitTask(0)
+
+	assert.Nil(t, groupCtx.Err(), "Expected the group context not to be canceled, got: %v", groupCtx.Err())
+
+	results, err := group.Wait()
+
+	assert.Equal(t, []error{context.DeadlineExceeded}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{context.DeadlineExceeded}, err)
+	assert.Equal(t, []int{2}, results, "Expected results to be: %v, got: %v", []int{2}, results)
+}
+
+// testGroupErrorCount checks that errors dropped after the threshold are still counted.
+func testGroupErrorCount(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 2)
+
+	for i := 0; i < 5; i++ {
+		group.Go(func() ([]int, error) {
+			return nil, err1
+		})
+	}
+
+	_, err := group.Wait()
+
+	assert.Len(t, unwrapErrors(err), 2, "Expected 2 errors, got: %d", len(unwrapErrors(err)))
+	assert.Equal(t, 5, group.ErrorCount(), "Expected 5 errors in total, got: %d", group.ErrorCount())
+	assert.Equal(t, 3, group.DroppedErrors(), "Expected 3 dropped errors, got: %d", group.DroppedErrors())
+
+	var zero Group[int]
+
+	for i := 0; i < 3; i++ {
+		zero.Go(func() ([]int, error) {
+			return nil, err2
+		})
+	}
+
+	_, _ = zero.Wait()
+
+	assert.Equal(t, 3, zero.ErrorCount(), "Expected 3 errors in total, got: %d", zero.ErrorCount())
+	assert.Equal(t, 0, zero.DroppedErrors(), "Expected no dropped errors, got: %d", zero.DroppedErrors())
+}
+
+// testGroupReset checks that a Group can be reused after Reset without leaking results or errors across rounds.
+func testGroupReset(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+
+	for round := 0; round < 3; round++ {
+		round := round
+
+		group.Go(func() ([]int, error) {
+			return []int{round}, nil
+		})
+
+		group.GoCtx(func(ctx context.Context) ([]int, error) {
+			if round == 1 {
+				return nil, err1
+			}
+
+			return []int{round * 10}, nil
+		})
+
+		results, err := group.Wait()
+
+		assert.ErrorIs(t, ctx.Err(), context.Canceled, "Expected the round context to be canceled after Wait, got: %v", ctx.Err())
+
+		if round == 1 {
+			assert.Equal(t, err1, unwrapErrors(err)[0], "Expected error to be: %v, got: %v", err1, err)
+			assert.Equal(t, []int{round}, results, "Expected results to be: %v, got: %v", []int{round}, results)
+		} else {
+			assert.Nil(t, err, "Expected no error, got: %v", err)
+			assert.ElementsMatch(t, []int{round, round * 10}, results, "Expected results to be: %v, got: %v", []int{round, round * 10}, results)
+		}
+
+		ctx = group.Reset()
+
+		assert.Nil(t, ctx.Err(), "Expected a live context after Reset, got: %v", ctx.Err())
+		assert.Equal(t, 1, group.threshold, "Expected the threshold to be kept, got: %d", group.threshold)
+	}
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, nil
+	})
+
+	assert.Panics(t, func() { group.Reset() }, "Expected Reset to panic while tasks are running")
+
+	_, _ = group.Wait()
+}
+
+// testGroupGoOne checks that GoOne collects single results, and skips them on error.
+func testGroupGoOne(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	group.GoOne(func() (int, error) {
+		return 1, nil
+	})
+
+	group.GoOne(func() (int, error) {
+		return 2, err1
+	})
+
+	results, err := group.Wait()
+
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// testGroupKeepResultsOnError checks that results returned along with an error are kept or discarded as configured.
+func testGroupKeepResultsOnError(t *testing.T) {
+	t.Parallel()
+
+	for _, keep := range []bool{true, false} {
+		group := Group[int]{}
+		group.SetKeepResultsOnError(keep)
+
+		group.Go(func() ([]int, error) {
+			return []int{1, 2}, err1
+		})
+
+		group.Go(func() ([]int, error) {
+			return []int{3}, nil
+		})
+
+		results, err := group.Wait()
+
+		expected := []int{3}
+		if keep {
+			expected = []int{1, 2, 3}
+		}
+
+		assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+		assert.ElementsMatch(t, expected, results, "Expected results to be: %v, got: %v", expected, results)
+	}
+}
+
+// testGroupOnComplete checks that the progress callback is invoked once per completed task.
+func testGroupOnComplete(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	var (
+		mu                        sync.Mutex
+		calls, completed, errored int
+	)
+
+	group.SetOnComplete(func(c, e int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls++
+
+		if c > completed {
+			completed = c
+		}
+
+		if e > errored {
+			errored = e
+		}
+	})
+
+	for i := 0; i < 4; i++ {
+		i := i
+
+		group.Go(func() ([]int, error) {
+			if i%2 == 0 {
+				return nil, err1
+			}
+
+			return []int{i}, nil
+		})
+	}
+
+	_, _ = group.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, 4, calls, "Expected 4 calls, got: %d", calls)
+	assert.Equal(t, 4, completed, "Expected 4 completed tasks, got: %d", completed)
+	assert.Equal(t, 2, errored, "Expected 2 errors, got: %d", errored)
+}
+
+// testGroupErrorFormatter checks that the error message uses the configured formatter.
+func testGroupErrorFormatter(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+	group.SetErrorFormatter(func(errs []error) string {
+		msgs := make([]string, 0, len(errs))
+		for _, err := range errs {
+			msgs = append(msgs, err.Error())
+		}
+
+		return fmt.Sprintf("%d errors occurred: %s", len(errs), strings.Join(msgs, "; "))
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, err := group.Wait()
+
+	assert.EqualError(t, err, "2 errors occurred: Error 1; Error 2", "Expected a formatted error message")
+	assert.True(t, errors.Is(err, err2), "Expected error to be: %v, got: %v", err2, err)
+}
+
+// testGroupErrorJoiner checks that the errors are aggregated by the joiner.
+func testGroupErrorJoiner(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+	group.SetErrorJoiner(func(errs []error) error {
+		return errors.Join(errs...)
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, err := group.Wait()
+
+	assert.EqualError(t, err, errors.Join(err1, err2).Error(), "Expected joined errors, got: %v", err)
+	assert.True(t, errors.Is(err, err2), "Expected error to be: %v, got: %v", err2, err)
+
+	var multiErr *MultiError
+	assert.False(t, errors.As(err, &multiErr), "Expected no *MultiError, got: %v", err)
+
+	group.Reset()
+	_, err = group.Wait()
+	assert.NoError(t, err, "Expected no error without errors, got: %v", err)
+}
+
+// testGroupConcurrentErrors checks that the threshold is applied deterministically with many concurrent failures.
+// Run it with -race to check the state transitions for data races.
+func testGroupConcurrentErrors(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 10)
+	group.SetTimeline(true)
+
+	for i := 0; i < 500; i++ {
+		i := i
+
+		group.Go(func() ([]int, error) {
+			return []int{i}, fmt.Errorf("task %d: %w", i, err1)
+		})
+	}
+
+	timeline := group.WaitTimeline()
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "Expected context to be canceled, got: %v", ctx.Err())
+
+	results, err := group.Wait()
+
+	var thresholds, cancels int
+	for _, entry := range timeline {
+		switch entry.Event {
+		case EventThresholdReached:
+			thresholds++
+		case EventCanceled:
+			cancels++
+		}
+	}
+
+	assert.Len(t, unwrapErrors(err), 10, "Expected 10 errors, got: %d", len(unwrapErrors(err)))
+	assert.Equal(t, 500, group.ErrorCount(), "Expected 500 errors in total, got: %d", group.ErrorCount())
+	assert.Equal(t, 490, group.DroppedErrors(), "Expected 490 dropped errors, got: %d", group.DroppedErrors())
+	assert.Len(t, results, 500, "Expected 500 results, got: %d", len(results))
+	assert.Equal(t, 1, thresholds, "Expected the threshold to be reached once, got: %d", thresholds)
+	assert.Equal(t, 1, cancels, "Expected the context to be canceled once, got: %d", cancels)
+}
+
+// testGroupLiveCounts checks that the result and error counts can be read while tasks are running.
+func testGroupLiveCounts(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	release := make(chan struct{})
+
+	group.Go(func() ([]int, error) {
+		return []int{1, 2}, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{3}, nil
+	})
+
+	_, _ = group.WaitTask(0)
+
+	assert.Equal(t, 2, group.ResultsLen(), "Expected 2 results so far, got: %d", group.ResultsLen())
+	assert.Equal(t, 1, group.ErrorsLen(), "Expected 1 error so far, got: %d", group.ErrorsLen())
+
+	close(release)
+	_, _ = group.Wait()
+
+	assert.Equal(t, 3, group.ResultsLen(), "Expected 3 results, got: %d", group.ResultsLen())
+}
+
+// testGroupMaxResults checks that the Group cancels the context and truncates the results once the maximum is reached.
+func testGroupMaxResults(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 3)
+	group.SetMaxResults(3)
+
+	group.Go(func() ([]int, error) {
+		return []int{1, 2}, nil
+	})
+
+	_, _ = group.WaitTask(0)
+
+	assert.Nil(t, ctx.Err(), "Expected context not to be canceled below the maximum, got: %v", ctx.Err())
+
+	group.Go(func() ([]int, error) {
+		return []int{3, 4}, nil
+	})
+
+	_, _ = group.WaitTask(1)
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "Expected context to be canceled at the maximum, got: %v", ctx.Err())
+
+	group.Go(func() ([]int, error) {
+		return []int{5}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3}, results)
+}
+
+// testGroupGoWeight checks that weighted tasks occupy several slots of the limit.
+func testGroupGoWeight(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(4)
+
+	var used, maxUsed atomic.Int32
+
+	task := func(w int32) func() ([]int, error) {
+		return func() ([]int, error) {
+			n := used.Add(w)
+			defer used.Add(-w)
+
+			for m := maxUsed.Load(); n > m && !maxUsed.CompareAndSwap(m, n); m = maxUsed.Load() {
+			}
+
+			time.Sleep(5 * time.Millisecond)
+
+			return []int{int(w)}, nil
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, group.GoWeight(3, task(3)), "Expected a task within the limit to be accepted")
+		group.Go(task(1))
+	}
+
+	err := group.GoWeight(5, task(5))
+
+	assert.ErrorIs(t, err, ErrWeightExceedsLimit, "Expected error to be: %v, got: %v", ErrWeightExceedsLimit, err)
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, results, 6, "Expected 6 results, got: %d", len(results))
+	assert.LessOrEqual(t, maxUsed.Load(), int32(4), "Expected at most 4 slots in use, got: %d", maxUsed.Load())
+}
+
+// testGroupDedupeErrors checks that identical errors are collected once with their occurrence count.
+func testGroupDedupeErrors(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetDedupeErrors(true)
+
+	for i := 0; i < 487; i++ {
+		group.Go(func() ([]int, error) {
+			return nil, fmt.Errorf("connection refused")
+		})
+	}
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, err := group.Wait()
+
+	var me *MultiError
+	assert.True(t, errors.As(err, &me), "Expected a *MultiError, got: %v", err)
+	assert.Len(t, me.Errors(), 3, "Expected 3 unique errors, got: %v", me.Errors())
+	assert.ElementsMatch(t, []int{487, 1, 1}, me.Counts(), "Expected occurrence counts, got: %v", me.Counts())
+	assert.Contains(t, err.Error(), "connection refused (x487)", "Expected the occurrence count in the message, got: %v", err)
+	assert.NotContains(t, err.Error(), "Error 1 (x", "Expected no count for a single occurrence, got: %v", err)
+	assert.True(t, errors.Is(err, err2), "Expected error to be: %v, got: %v", err2, err)
+	assert.Equal(t, 489, group.ErrorCount(), "Expected every occurrence to be counted, got: %v", group.ErrorCount())
+}
+
+// testGroupErrorSampleSize checks that only a sample of the distinct errors is kept, and the rest is counted.
+func testGroupErrorSampleSize(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+	group.SetDedupeErrors(true)
+	group.SetErrorSampleSize(2)
+
+	for _, err := range []error{err1, err1, err2, err3, err3, err1} {
+		err := err
+		group.Go(func() ([]int, error) {
+			return nil, err
+		})
+	}
+
+	_, err := group.Wait()
+
+	var me *MultiError
+	assert.True(t, errors.As(err, &me), "Expected a *MultiError, got: %v", err)
+	assert.Equal(t, []error{err1, err2}, me.Errors(), "Expected the sampled errors, got: %v", me.Errors())
+	assert.Equal(t, []int{3, 1}, me.Counts(), "Expected occurrence counts, got: %v", me.Counts())
+	assert.Equal(t, 1, me.Omitted(), "Expected 1 omitted error, got: %v", me.Omitted())
+	assert.Equal(t, "Error 1 (x3)\nError 2\n(1 more errors omitted)", err.Error(), "Expected the omitted errors in the message, got: %v", err)
+}
+
+// testGroupCountUniqueErrors checks that the threshold counts either every occurrence or only distinct errors.
+func testGroupCountUniqueErrors(t *testing.T) {
+	t.Parallel()
+
+	all, ctx := WithErrorsThreshold[int](context.Background(), 3)
+	all.SetLimit(1)
+	all.SetDedupeErrors(true)
+
+	for i := 0; i < 3; i++ {
+		all.Go(func() ([]int, error) {
+			return nil, err1
+		})
+	}
+
+	assert.Eventually(t, func() bool { return ctx.Err() != nil }, time.Second, time.Millisecond,
+		"Expected the context to be canceled by repeated errors")
+	_, err := all.Wait()
+	assert.EqualError(t, err, "Error 1 (x3)", "Expected a single deduplicated error")
+
+	unique, ctx := WithErrorsThreshold[int](context.Background(), 2)
+	unique.SetLimit(1)
+	unique.SetDedupeErrors(true)
+	unique.SetCountUniqueErrors(true)
+
+	for i := 0; i < 5; i++ {
+		unique.Go(func() ([]int, error) {
+			return nil, err1
+		})
+	}
+
+	_, _ = unique.WaitTask(4)
+	assert.NoError(t, ctx.Err(), "Expected repeated errors not to reach the threshold, got: %v", ctx.Err())
+
+	unique.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, err = unique.Wait()
+	assert.EqualError(t, err, "Error 1 (x5)\nError 2", "Expected two distinct errors")
+	assert.Equal(t, 0, unique.DroppedErrors(), "Expected no dropped errors, got: %v", unique.DroppedErrors())
+}
+
+// testGroupGoCtxCanceled checks that GoCtx and GoWithTimeout do not run tasks once the group context is canceled, but register them as skipped, so the task indices follow the submission order.
+func testGroupGoCtxCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.Cancel()
+
+	var ran atomic.Bool
+	group.GoCtx(func(ctx context.Context) ([]int, error) {
+		ran.Store(true)
+		return []int{1}, nil
+	})
+	group.GoWithTimeout(time.Second, func(ctx context.Context) ([]int, error) {
+		ran.Store(true)
+		return []int{2}, nil
+	})
+
+	_, errCtx := group.WaitTask(0)
+	_, errTimeout := group.WaitTask(1)
+	results, err := group.Wait()
+
+	assert.False(t, ran.Load(), "Expected the task not to run")
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+	assert.ErrorIs(t, errCtx, context.Canceled, "Expected error to be: %v, got: %v", context.Canceled, errCtx)
+	assert.ErrorIs(t, errTimeout, context.Canceled, "Expected error to be: %v, got: %v", context.Canceled, errTimeout)
+	assert.Len(t, group.WaitDetailed(), 2, "Expected 2 outcomes, got: %d", len(group.WaitDetailed()))
+}
+
+// testGroupOrderedResults checks that ordered results follow the submission order rather than the completion order.
+func testGroupOrderedResults(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetOrderedResults(true)
+
+	for i := 0; i < 5; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			time.Sleep(time.Duration(5-i) * 5 * time.Millisecond)
+			return []int{i * 2, i*2 + 1}, nil
+		})
+	}
+
+	group.GoCollect(func(emit func(int)) error {
+		emit(10)
+		emit(11)
+		return nil
+	})
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, results, "Expected results in submission order, got: %v", results)
+}
+
+// testGroupGoWithTimeout checks that a task overrunning its deadline records a timeout error and loses its results.
+func testGroupGoWithTimeout(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 2)
+
+	group.GoWithTimeout(time.Second, func(ctx context.Context) ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.GoWithTimeout(time.Millisecond, func(ctx context.Context) ([]int, error) {
+		<-ctx.Done()
+		return []int{2}, ctx.Err()
+	})
+
+	group.GoWithTimeout(time.Millisecond, func(ctx context.Context) ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return []int{3}, nil
+	})
+
+	_, _ = group.WaitTask(1)
+	_, _ = group.WaitTask(2)
+
+	assert.Error(t, ctx.Err(), "Expected the timeouts to reach the threshold")
+
+	results, err := group.Wait()
+	errs := unwrapErrors(err)
+
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.Len(t, errs, 2, "Expected 2 errors, got: %v", errs)
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "Expected a deadline error, got: %v", err)
+		assert.Contains(t, err.Error(), "timed out after 1ms", "Expected the timeout in the error, got: %v", err)
+	}
+}
+
+// testGroupGoWithTimeoutParentDeadline checks that the deadline of the parent context is not reported as the timeout of the task.
+func testGroupGoWithTimeoutParentDeadline(t *testing.T) {
+	t.Parallel()
+	parent, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	group, _ := WithErrorsThreshold[int](parent, 1)
+
+	group.GoWithTimeout(time.Hour, func(ctx context.Context) ([]int, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	_, err := group.Wait()
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "Expected a deadline error, got: %v", err)
+	assert.NotContains(t, err.Error(), "timed out", "Expected the parent deadline not to be reported as a timeout, got: %v", err)
+}
+
+// testGroupResultsTarget checks that a results target cancels the context once reached and drops the later errors.
+func testGroupResultsTarget(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithResultsTarget[int](context.Background(), 2)
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	// Each task waits for the previous one, so the error comes first.
+	for i := 1; i <= 2; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			_, _ = group.WaitTask(i - 1)
+			return []int{i}, nil
+		})
+	}
+
+	group.Go(func() ([]int, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	results, err := group.Wait()
+
+	assert.ElementsMatch(t, []int{1, 2}, results, "Expected results to be: %v, got: %v", []int{1, 2}, results)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.Equal(t, 1, group.DroppedErrors(), "Expected the error after the target to be dropped, got: %v", group.DroppedErrors())
+}
+
+// testGroupCapacity checks that the results are collected into a slice allocated with the expected capacity.
+func testGroupCapacity(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetCapacity(100)
+
+	for i := 0; i < 10; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			return []int{i}, nil
+		})
+	}
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, results, 10, "Expected 10 results, got: %v", results)
+	assert.Equal(t, 100, cap(results), "Expected the capacity to be: 100, got: %v", cap(results))
+
+	group.Reset()
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	again, _ := group.Wait()
+
+	assert.Equal(t, 100, cap(again), "Expected the capacity to be kept by Reset, got: %v", cap(again))
+	assert.Len(t, results, 10, "Expected the results of the first round to be left untouched, got: %v", results)
+}
+
+// testGroupShardedResults checks that sharded results are merged by Wait in submission order, with the filters applied.
+func testGroupShardedResults(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetShardedResults(true)
+	group.SetDedupKey(func(v int) string {
+		return strconv.Itoa(v)
+	})
+
+	for i := 0; i < 5; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			time.Sleep(time.Duration(5-i) * time.Millisecond)
+			return []int{i, 100}, nil
+		})
+	}
+
+	results, err := group.Wait()
+
+	var ids []int
+	for _, r := range results {
+		if r != 100 {
+			ids = append(ids, r)
+		}
+	}
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, results, 6, "Expected the duplicates to be filtered, got: %v", results)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, ids, "Expected results in submission order, got: %v", results)
+	assert.Equal(t, 6, group.ResultsLen(), "Expected every result to be counted, got: %v", group.ResultsLen())
+}
+
+// testGroupErrorRateThreshold checks that the context is canceled once the failure rate is exceeded after the minimum sample.
+func testGroupErrorRateThreshold(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorRateThreshold[int](context.Background(), 0.1, 10)
+	group.SetLimit(1)
+
+	run := func(err error) {
+		group.Go(func() ([]int, error) {
+			return []int{1}, err
+		})
+	}
+
+	run(err1)
+	_, _ = group.WaitTask(0)
+	assert.NoError(t, ctx.Err(), "Expected a failure not to cancel before the minimum sample, got: %v", ctx.Err())
+
+	for i := 0; i < 9; i++ {
+		run(nil)
+	}
+
+	_, _ = group.WaitTask(9)
+	assert.NoError(t, ctx.Err(), "Expected a rate of 0.1 not to cancel, got: %v", ctx.Err())
+
+	run(err2)
+	_, _ = group.WaitTask(10)
+	assert.Error(t, ctx.Err(), "Expected a rate above 0.1 to cancel the context")
+
+	_, err := group.Wait()
+	assert.Equal(t, []error{err1, err2}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1, err2}, err)
+}
+
+// testGroupFullErrorCollection checks that errors past the threshold are kept, and the triggering ones are told apart.
+func testGroupFullErrorCollection(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 2)
+	group.SetFullErrorCollection(true)
+
+	for i, err := range []error{err1, err2, err3} {
+		err := err
+		group.Go(func() ([]int, error) {
+			return nil, err
+		})
+		_, _ = group.WaitTask(i)
+	}
+
+	_, err := group.Wait()
+
+	var me *MultiError
+	assert.True(t, errors.As(err, &me), "Expected a *MultiError, got: %v", err)
+	assert.Equal(t, []error{err1, err2, err3}, me.Errors(), "Expected every error to be collected, got: %v", me.Errors())
+	assert.Equal(t, []error{err1, err2}, me.Triggering(), "Expected the errors that reached the threshold, got: %v", me.Triggering())
+	assert.Equal(t, 0, group.DroppedErrors(), "Expected no dropped errors, got: %v", group.DroppedErrors())
+}
+
+// testGroupAbort checks that Abort cancels the context, ignores new tasks and discards the running ones.
+func testGroupAbort(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	_, _ = group.WaitTask(0)
+
+	group.Go(func() ([]int, error) {
+		<-ctx.Done()
+		return []int{2}, ctx.Err()
+	})
+
+	group.Abort()
+	assert.Error(t, ctx.Err(), "Expected the context to be canceled")
+
+	var ran atomic.Bool
+	group.Go(func() ([]int, error) {
+		ran.Store(true)
+		return []int{3}, nil
+	})
+	assert.False(t, group.TryGo(func() ([]int, error) {
+		ran.Store(true)
+		return []int{4}, nil
+	}), "Expected TryGo to refuse new tasks")
+	_ = group.GoWeight(2, func() ([]int, error) {
+		ran.Store(true)
+		return []int{5}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.False(t, ran.Load(), "Expected no task to run after Abort")
+	assert.NoError(t, err, "Expected the error of the aborted task to be discarded, got: %v", err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// testGroupGoOnce checks that tasks with the same key run once.
+func testGroupGoOnce(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	var runs atomic.Int32
+	for i := 0; i < 3; i++ {
+		submitted := group.GoOnce("a", func() ([]int, error) {
+			runs.Add(1)
+			return []int{1}, nil
+		})
+		assert.Equal(t, i == 0, submitted, "Expected only the first task to be submitted, got: %v", submitted)
+	}
+
+	assert.True(t, group.GoOnce("b", func() ([]int, error) {
+		runs.Add(1)
+		return []int{2}, nil
+	}), "Expected a task with another key to be submitted")
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, int32(2), runs.Load(), "Expected 2 runs, got: %v", runs.Load())
+	assert.ElementsMatch(t, []int{1, 2}, results, "Expected results to be: %v, got: %v", []int{1, 2}, results)
+
+	group.Reset()
+	assert.True(t, group.GoOnce("a", func() ([]int, error) {
+		return nil, nil
+	}), "Expected Reset to forget the keys")
+	_, _ = group.Wait()
+}
+
+// testGroupGoPriority checks that queued tasks start by decreasing priority.
+func testGroupGoPriority(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+
+	release := make(chan struct{})
+	group.Go(func() ([]int, error) {
+		<-release
+		return nil, nil
+	})
+
+	var (
+		mu    sync.Mutex
+		order []int
+	)
+
+	for _, priority := range []int{1, 3, 2} {
+		priority := priority
+		group.GoPriority(priority, func() ([]int, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, priority)
+
+			return []int{priority}, nil
+		})
+	}
+
+	assert.Eventually(t, func() bool {
+		group.sem.mu.Lock()
+		defer group.sem.mu.Unlock()
+
+		return group.sem.waiters.Len() == 3
+	}, time.Second, time.Millisecond, "Expected the tasks to be queued")
+
+	close(release)
+	_, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{3, 2, 1}, order, "Expected tasks to start by priority, got: %v", order)
+}
+
+// testGroupResultFilter checks that the filter drops and transforms the results as they are collected.
+func testGroupResultFilter(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+	group.SetResultFilter(func(v int) (int, bool) {
+		return v * 10, v != 0
+	})
+
+	res := []int{1, 0, 2}
+	group.Go(func() ([]int, error) {
+		return res, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{0, 3}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{10, 20, 30}, results, "Expected results to be: %v, got: %v", []int{10, 20, 30}, results)
+	assert.Equal(t, []int{1, 0, 2}, res, "Expected the task results to be left as is, got: %v", res)
+}
+
+// testGroupGoAfterWait checks that Wait returns the same snapshot each time, and that submitting a task after Wait or WaitContext panics until Reset.
+func testGroupGoAfterWait(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, err1
+	})
+
+	results, err := group.Wait()
+	again, errAgain := group.Wait()
+
+	assert.Equal(t, results, again, "Expected the same results, got: %v", again)
+	assert.Equal(t, err, errAgain, "Expected the same error, got: %v", errAgain)
+
+	assert.PanicsWithValue(t, errWaited, func() {
+		group.Go(func() ([]int, error) {
+			return nil, nil
+		})
+	}, "Expected Go to panic after Wait")
+	assert.Panics(t, func() {
+		group.TryGo(func() ([]int, error) {
+			return nil, nil
+		})
+	}, "Expected TryGo to panic after Wait")
+
+	group.Reset()
+	assert.True(t, group.TryGo(func() ([]int, error) {
+		return []int{2}, nil
+	}), "Expected TryGo to run the task after Reset")
+
+	results, err = group.Wait()
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{2}, results, "Expected results to be: %v, got: %v", []int{2}, results)
+
+	group.Reset()
+	release := make(chan struct{})
+	defer close(release)
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{3}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _ = group.WaitContext(ctx)
+
+	assert.PanicsWithValue(t, errWaited, func() {
+		group.Go(func() ([]int, error) {
+			return nil, nil
+		})
+	}, "Expected Go to panic after WaitContext returned early")
+}
+
+// testGroupCancelCause checks that the context of a group whose threshold is reached is canceled with ErrThresholdReached as cause.
+func testGroupCancelCause(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	_, _ = group.WaitTask(0)
+	cause := context.Cause(ctx)
+
+	assert.ErrorIs(t, cause, ErrThresholdReached, "Expected cause to be: %v, got: %v", ErrThresholdReached, cause)
+	assert.ErrorIs(t, cause, err1, "Expected cause to wrap: %v, got: %v", err1, cause)
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "Expected the context to be canceled, got: %v", ctx.Err())
+
+	_, _ = group.Wait()
+
+	parent, cancel := context.WithCancel(context.Background())
+	group, ctx = WithErrorsThreshold[int](parent, 1)
+	cancel()
+
+	assert.Equal(t, context.Canceled, context.Cause(ctx), "Expected the cause of a parent cancellation, got: %v", context.Cause(ctx))
+	_, _ = group.Wait()
+}
+
+// testGroupSkipOnCancel checks that the tasks started once the context is canceled are skipped.
+func testGroupSkipOnCancel(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetSkipOnCancel(true)
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	_, _ = group.WaitTask(0)
+
+	var ran atomic.Bool
+	group.Go(func() ([]int, error) {
+		ran.Store(true)
+		return []int{1}, nil
+	})
+
+	_, skipErr := group.WaitTask(1)
+	_, err := group.Wait()
+
+	assert.False(t, ran.Load(), "Expected the task not to run once the context is canceled")
+	assert.ErrorIs(t, skipErr, ErrTaskSkipped, "Expected error to be: %v, got: %v", ErrTaskSkipped, skipErr)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected the skipped task to record no error, got: %v", err)
+	assert.Equal(t, 1, group.Stats().Canceled, "Expected the skipped task to count as canceled")
+}
+
+// testGroupDiscardResults checks that the results are discarded, and not kept with the tasks, while the errors are still collected.
+func testGroupDiscardResults(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 2)
+	group.SetDiscardResults(true)
+
+	group.Go(func() ([]int, error) {
+		return make([]int, 1000), nil
+	})
+	group.Go(func() ([]int, error) {
+		return []int{3}, err1
+	})
+
+	results, err := group.Wait()
+
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	for _, task := range group.tasks {
+		assert.Nil(t, task.res, "Expected the results of task %d not to be kept, got: %d", task.index, len(task.res))
+	}
+
+	_, taskErr := group.WaitTask(1)
+	assert.Equal(t, err1, taskErr, "Expected the error of the task to be kept, got: %v", taskErr)
+}
+
+func BenchmarkShardedResults(b *testing.B) {
+	for _, sharded := range []bool{false, true} {
+		sharded := sharded
+		b.Run(fmt.Sprintf("sharded=%v", sharded), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				group := Group[int]{}
+				group.SetShardedResults(sharded)
+				for j := 0; j < 1000; j++ {
+					group.Go(func() ([]int, error) {
+						return []int{1, 2, 3, 4, 5, 6, 7, 8}, nil
+					})
+				}
+				_, _ = group.Wait()
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package resultgroup
+
+import (
+	"context"
+	"time"
+)
+
+// attempt is the outcome of one attempt of a hedged task.
+type attempt[T any] struct {
+	res []T
+	err error
+}
+
+// GoHedged runs a single task whose attempts, given by fs, are started one
+// after the other, for fanning out to replicated backends: the first attempt
+// starts right away, and each following one starts once the previous one
+// has not returned within delay, or as soon as it fails. The results of the
+// first attempt that succeeds are collected, and the context passed to the
+// other attempts is canceled; GoHedged waits for them to return. If every
+// attempt fails, their errors are recorded joined, counting as one error
+// toward the threshold.
+// At least one function must be given.
+func (g *Group[T]) GoHedged(delay time.Duration, fs ...func(ctx context.Context) ([]T, error)) {
+	g.checkNil()
+
+	if len(fs) == 0 {
+		panic("at least one function must be given")
+	}
+
+	g.GoCtx(func(ctx context.Context) ([]T, error) {
+		return hedge(ctx, delay, fs, g.newTimer)
+	})
+}
+
+// hedge runs the attempts of a hedged task, and returns the outcome of the
+// first one that succeeds, or the joined errors of all of them.
+func hedge[T any](ctx context.Context, delay time.Duration, fs []func(ctx context.Context) ([]T, error), newTimer func(time.Duration) Timer) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The channel can hold every outcome, so the attempts never block.
+	outcomes := make(chan attempt[T], len(fs))
+	launched := 0
+	launch := func() {
+		f := fs[launched]
+		launched++
+
+		go func() {
+			res, err := call(func() ([]T, error) {
+				return f(ctx)
+			})
+			outcomes <- attempt[T]{res: res, err: err}
+		}()
+	}
+
+	launch()
+
+	timer := newTimer(delay)
+	defer func() { timer.Stop() }()
+
+	var errs []error
+	for len(errs) < len(fs) {
+		select {
+		case out := <-outcomes:
+			if out.err == nil {
+				cancel()
+				for i := len(errs) + 1; i < launched; i++ {
+					<-outcomes
+				}
+
+				return out.res, nil
+			}
+
+			errs = append(errs, out.err)
+			if launched < len(fs) && launched == len(errs) {
+				launch()
+				timer.Stop()
+				timer = newTimer(delay)
+			}
+		case <-timer.C():
+			if launched < len(fs) {
+				launch()
+				timer = newTimer(delay)
+			}
+		}
+	}
+
+	return nil, Join(errs...)
+}
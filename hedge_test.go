@@ -0,0 +1,64 @@
+package resultgroup
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoHedged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("backup wins", testGoHedgedBackupWins)
+	t.Run("all fail", testGoHedgedAllFail)
+}
+
+// testGoHedgedBackupWins checks that a backup attempt starts after the delay, and that the slow primary is canceled once it wins.
+func testGoHedgedBackupWins(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	var canceled atomic.Bool
+	group.GoHedged(10*time.Millisecond,
+		func(ctx context.Context) ([]int, error) {
+			<-ctx.Done()
+			canceled.Store(true)
+			return nil, ctx.Err()
+		},
+		func(ctx context.Context) ([]int, error) {
+			return []int{2}, nil
+		},
+	)
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{2}, results, "Expected results to be: %v, got: %v", []int{2}, results)
+	assert.True(t, canceled.Load(), "Expected the primary attempt to be canceled before Wait returns")
+}
+
+// testGoHedgedAllFail checks that a failure starts the next attempt right away, and that the errors are joined once all attempts fail.
+func testGoHedgedAllFail(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	start := time.Now()
+	group.GoHedged(time.Hour,
+		func(ctx context.Context) ([]int, error) {
+			return nil, err1
+		},
+		func(ctx context.Context) ([]int, error) {
+			return nil, err2
+		},
+	)
+
+	_, err := group.Wait()
+
+	assert.Less(t, time.Since(start), time.Second, "Expected failures not to wait for the delay")
+	assert.ErrorIs(t, err, err1, "Expected error to be: %v, got: %v", err1, err)
+	assert.ErrorIs(t, err, err2, "Expected error to be: %v, got: %v", err2, err)
+	assert.Equal(t, 1, group.ErrorCount(), "Expected the errors to count once, got: %v", group.ErrorCount())
+}
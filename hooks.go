@@ -0,0 +1,79 @@
+package resultgroup
+
+import "context"
+
+// SetOnTaskStart sets a callback that is invoked each time a task starts
+// running, with its submission index, for example to drive progress bars or
+// logs. Like the callback of SetOnComplete, it is invoked without holding
+// the group mutex, from the goroutines of the tasks, so it must be safe for
+// concurrent use.
+func (g *Group[T]) SetOnTaskStart(onTaskStart func(index int)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.onTaskStart = onTaskStart
+}
+
+// SetOnTaskDone sets a callback that is invoked each time a task returns,
+// after its outcome is collected, with its submission index, the number of
+// results it returned and the error it returned, if any. Like the callback
+// of SetOnComplete, it is invoked without holding the group mutex, and must
+// be safe for concurrent use.
+func (g *Group[T]) SetOnTaskDone(onTaskDone func(index, results int, err error)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.onTaskDone = onTaskDone
+}
+
+// SetOnThresholdReached sets a callback that is invoked once, when the error
+// threshold is reached and the group context is canceled. It is invoked
+// without holding the group mutex, from the goroutine of the task whose
+// error reached the threshold.
+func (g *Group[T]) SetOnThresholdReached(onThresholdReached func()) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.onThreshold = onThresholdReached
+}
+
+// SetGoWrapper sets a function wrapped around each task function, for
+// example to attach pprof labels, request-scoped loggers or custom
+// reporters consistently to every task of the group. The wrapper is called
+// from the goroutine of the task with the group context, and must call run
+// exactly once, which calls the task function. A panic in the wrapper is
+// recovered like a panic in the task function. A nil wrapper, the default,
+// calls the task functions directly.
+func (g *Group[T]) SetGoWrapper(wrapper func(ctx context.Context, run func())) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.wrapper = wrapper
+}
+
+// wrapped returns f wrapped by the wrapper set with SetGoWrapper, if any.
+func (g *Group[T]) wrapped(f func() ([]T, error)) func() ([]T, error) {
+	g.mutex.Lock()
+	wrapper := g.wrapper
+	g.mutex.Unlock()
+
+	if wrapper == nil {
+		return f
+	}
+
+	return func() (res []T, err error) {
+		wrapper(g.context(), func() {
+			res, err = f()
+		})
+
+		return res, err
+	}
+}
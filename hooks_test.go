@@ -0,0 +1,103 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHooks checks that the progress hooks observe every task and the threshold once.
+func TestHooks(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 2)
+
+	var (
+		mutex     sync.Mutex
+		started   []int
+		done      = map[int]int{}
+		errs      []error
+		threshold atomic.Int32
+	)
+
+	group.SetOnTaskStart(func(index int) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		started = append(started, index)
+	})
+	group.SetOnTaskDone(func(index, results int, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		done[index] = results
+		if err != nil {
+			errs = append(errs, err)
+		}
+	})
+	group.SetOnThresholdReached(func() {
+		threshold.Add(1)
+	})
+	group.SetLimit(1)
+
+	group.Go(func() ([]int, error) {
+		return []int{1, 2}, nil
+	})
+
+	for i := 0; i < 3; i++ {
+		group.Go(func() ([]int, error) {
+			return nil, err1
+		})
+	}
+
+	_, _ = group.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	assert.ElementsMatch(t, []int{0, 1, 2}, started, "Expected the started tasks, got: %v", started)
+	assert.Equal(t, map[int]int{0: 2, 1: 0, 2: 0}, done, "Expected the result counts by task, got: %v", done)
+	assert.Equal(t, []error{err1, err1}, errs, "Expected errors to be: %v, got: %v", []error{err1, err1}, errs)
+	assert.Equal(t, int32(1), threshold.Load(), "Expected the threshold hook to be called once, got: %v", threshold.Load())
+}
+
+// TestGoWrapper checks that the wrapper runs around every task with the group context, and that its panics are recovered.
+func TestGoWrapper(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 2)
+
+	var wrapped atomic.Int32
+	group.SetGoWrapper(func(wrapperCtx context.Context, run func()) {
+		assert.Equal(t, ctx, wrapperCtx, "Expected the group context")
+		wrapped.Add(1)
+		run()
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{2}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{1, 2}, results, "Expected results to be: %v, got: %v", []int{1, 2}, results)
+	assert.Equal(t, int32(2), wrapped.Load(), "Expected every task to be wrapped, got: %v", wrapped.Load())
+
+	group.Reset()
+	group.SetGoWrapper(func(ctx context.Context, run func()) {
+		panic("wrapper")
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{3}, nil
+	})
+
+	_, err = group.Wait()
+
+	var panicErr PanicError
+	assert.ErrorAs(t, err, &panicErr, "Expected a PanicError, got: %v", err)
+}
@@ -0,0 +1,65 @@
+package resultgroup
+
+import (
+	"context"
+	"time"
+)
+
+// Instrumentation observes the tasks of a Group, to create a span per task
+// or to record metrics such as task durations, error counts and the number
+// of tasks in flight. It is an interface, so the package does not depend on
+// a particular tracing or metrics library: an adapter on top of
+// OpenTelemetry only needs a few lines.
+type Instrumentation interface {
+	// StartTask is called right before the function of a task is called,
+	// with the group context, so spans can be parented to the span of the
+	// caller. The returned function, if not nil, is called once the task
+	// function has returned, with how long it ran and the error it
+	// returned, if any.
+	StartTask(ctx context.Context, index int, label string) func(duration time.Duration, err error)
+	// InFlight is called each time the number of submitted tasks that have
+	// not returned yet changes, with the new number.
+	InFlight(n int)
+}
+
+// SetInstrumentation sets the instrumentation of the group. StartTask and the
+// function it returns are called from the goroutines of the tasks, without
+// holding the group mutex, so they must be safe for concurrent use. InFlight
+// is called with the group mutex held, so the numbers are reported in
+// order, and it must not call methods of the group.
+// A nil instrumentation, the default, disables it.
+func (g *Group[T]) SetInstrumentation(inst Instrumentation) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.inst = inst
+}
+
+// instrumentTask reports the start of task t, and returns the function that
+// reports its end.
+func (g *Group[T]) instrumentTask(t *task[T]) func(time.Duration, error) {
+	g.mutex.Lock()
+	inst := g.inst
+	g.mutex.Unlock()
+
+	if inst == nil {
+		return func(time.Duration, error) {}
+	}
+
+	end := inst.StartTask(g.context(), t.index, t.label)
+	if end == nil {
+		return func(time.Duration, error) {}
+	}
+
+	return end
+}
+
+// reportInFlight reports the number of tasks in flight to the
+// instrumentation. It must be called with the mutex held.
+func (g *Group[T]) reportInFlight() {
+	if g.inst != nil {
+		g.inst.InFlight(g.inFlight)
+	}
+}
@@ -0,0 +1,67 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder is an Instrumentation that records what it observes.
+type recorder struct {
+	mutex    sync.Mutex
+	started  []string
+	errs     []error
+	inFlight []int
+}
+
+func (r *recorder) StartTask(ctx context.Context, index int, label string) func(time.Duration, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.started = append(r.started, label)
+
+	return func(duration time.Duration, err error) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		if err != nil {
+			r.errs = append(r.errs, err)
+		}
+	}
+}
+
+func (r *recorder) InFlight(n int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.inFlight = append(r.inFlight, n)
+}
+
+// TestInstrumentation checks that the instrumentation observes each task and the number of tasks in flight.
+func TestInstrumentation(t *testing.T) {
+	t.Parallel()
+	rec := &recorder{}
+	group := Group[int]{}
+	group.SetInstrumentation(rec)
+	group.SetLimit(1)
+
+	group.GoNamed("ok", func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.GoNamed("failing", func() ([]int, error) {
+		return nil, err1
+	})
+
+	_, _ = group.Wait()
+
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	assert.Equal(t, []string{"ok", "failing"}, rec.started, "Expected every task to be started, got: %v", rec.started)
+	assert.Equal(t, []error{err1}, rec.errs, "Expected errors to be: %v, got: %v", []error{err1}, rec.errs)
+	assert.Equal(t, []int{1, 0, 1, 0}, rec.inFlight, "Expected the tasks in flight, got: %v", rec.inFlight)
+}
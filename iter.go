@@ -0,0 +1,69 @@
+//go:build go1.23
+
+package resultgroup
+
+import (
+	"context"
+	"iter"
+)
+
+// All returns an iterator over the results of the tasks, yielded as soon as
+// each task returns, like Stream, so they are not buffered until Wait. Once
+// all results are yielded, the iterator yields the error returned by Wait
+// with the zero value of T, if there is one.
+// All must be called before any task is started, and ranging over the
+// iterator must start once all tasks are submitted, since it calls Wait.
+// Go blocks while the limit set with SetLimit is reached, so with a limit
+// the tasks must be submitted from another goroutine. Stopping the
+// iteration early cancels the group context and waits for the running
+// tasks, discarding their results.
+func (g *Group[T]) All() iter.Seq2[T, error] {
+	g.checkNil()
+
+	stream := g.Stream()
+
+	return func(yield func(T, error) bool) {
+		done := make(chan error, 1)
+		go func() {
+			_, err := g.Wait()
+			done <- err
+		}()
+
+		for v := range stream {
+			if !yield(v, nil) {
+				g.Cancel()
+				for range stream {
+				}
+				<-done
+				return
+			}
+		}
+
+		if err := <-done; err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// GoEach runs f as a task of g for each input of seq, passing it the group
+// context like GoCtx. The inputs are consumed lazily: with a limit set with
+// SetLimit, the next input is only produced once a task can start. GoEach
+// stops consuming seq once the group context is canceled, and returns once
+// every input is submitted; call Wait to collect the results.
+// It is a function rather than a method because methods cannot have type
+// parameters of their own.
+func GoEach[I, T any](g *Group[T], seq iter.Seq[I], f func(context.Context, I) ([]T, error)) {
+	g.checkNil()
+
+	ctx := g.context()
+	for in := range seq {
+		if ctx.Err() != nil {
+			return
+		}
+
+		g.GoCtx(func(ctx context.Context) ([]T, error) {
+			return f(ctx, in)
+		})
+	}
+}
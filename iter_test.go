@@ -0,0 +1,115 @@
+//go:build go1.23
+
+package resultgroup
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testAllResults)
+	t.Run("break", testAllBreak)
+}
+
+func TestGoEach(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testGoEachResults)
+	t.Run("canceled", testGoEachCanceled)
+}
+
+// testAllResults checks that the iterator yields every result, then the error returned by Wait.
+func testAllResults(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	all := group.All()
+
+	group.Go(func() ([]int, error) {
+		return []int{1, 2}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{3}, err1
+	})
+
+	var results []int
+	var errs []error
+	for v, err := range all {
+		if err != nil {
+			errs = unwrapErrors(err)
+			continue
+		}
+		results = append(results, v)
+	}
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3}, results)
+	assert.Equal(t, []error{err1}, errs, "Expected errors to be: %v, got: %v", []error{err1}, errs)
+}
+
+// testAllBreak checks that stopping the iteration early cancels the group and does not leak tasks.
+func testAllBreak(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+	all := group.All()
+
+	for i := 0; i < 3; i++ {
+		group.Go(func() ([]int, error) {
+			return []int{1, 2, 3}, nil
+		})
+	}
+
+	for range all {
+		break
+	}
+
+	assert.Error(t, ctx.Err(), "Expected the context to be canceled")
+}
+
+// testGoEachResults checks that a task runs for every input of the sequence.
+func testGoEachResults(t *testing.T) {
+	t.Parallel()
+	group := Group[string]{}
+	group.SetLimit(2)
+
+	GoEach(&group, slices.Values([]int{1, 2, 3}), func(ctx context.Context, in int) ([]string, error) {
+		return []string{strconv.Itoa(in)}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, results, "Expected results to be: %v, got: %v", []string{"1", "2", "3"}, results)
+}
+
+// testGoEachCanceled checks that the sequence is no longer consumed once the group context is canceled.
+func testGoEachCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetLimit(1)
+
+	produced := 0
+	seq := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	GoEach(&group, seq, func(ctx context.Context, in int) ([]int, error) {
+		return nil, err1
+	})
+
+	_, err := group.Wait()
+
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.Less(t, produced, 10, "Expected the sequence to stop early, got %d inputs", produced)
+}
@@ -0,0 +1,101 @@
+package resultgroup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// errNilKeyedGroup is the panic message for methods called on a nil
+// *KeyedGroup.
+const errNilKeyedGroup = "resultgroup: method called on nil *KeyedGroup"
+
+// keyed is a result of a KeyedGroup task, along with its key.
+type keyed[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// KeyedGroup is like Group, but each task produces a single value for a key,
+// and Wait returns the values in a map, so each result stays associated with
+// the input that produced it.
+// To create a KeyedGroup without a context and error threshold, use the
+// struct directly:
+// group := resultgroup.KeyedGroup[K, V]{}
+type KeyedGroup[K comparable, V any] struct {
+	mutex sync.Mutex
+	keys  map[K]struct{}
+	group Group[keyed[K, V]]
+}
+
+// WithKeyedErrorsThreshold creates a new KeyedGroup with the provided context
+// and a threshold for the maximum number of errors, like WithErrorsThreshold.
+// Threshold must be greater than or equal to 1.
+func WithKeyedErrorsThreshold[K comparable, V any](ctx context.Context, threshold int) (group KeyedGroup[K, V], groupCtx context.Context) {
+	groupCtx = group.group.initThreshold(ctx, threshold)
+	return
+}
+
+// Go runs the provided function in a new goroutine, and stores the returned
+// value under key in the map returned by Wait. If the function returns an
+// error, no value is stored for key, and the error is handled like in
+// Group.Go.
+// Keys must be unique: submitting a key that was already submitted does not
+// run the function, and records an error wrapping ErrDuplicateKey instead.
+func (g *KeyedGroup[K, V]) Go(key K, f func() (V, error)) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	_, duplicate := g.keys[key]
+	if !duplicate {
+		if g.keys == nil {
+			g.keys = make(map[K]struct{})
+		}
+
+		g.keys[key] = struct{}{}
+	}
+	g.mutex.Unlock()
+
+	if duplicate {
+		g.group.addError(fmt.Errorf("%w: %v", ErrDuplicateKey, key))
+		return
+	}
+
+	g.group.Go(func() ([]keyed[K, V], error) {
+		v, err := f()
+		if err != nil {
+			return nil, err
+		}
+
+		return []keyed[K, V]{{key: key, value: v}}, nil
+	})
+}
+
+// SetLimit limits the number of tasks running at once, like Group.SetLimit.
+func (g *KeyedGroup[K, V]) SetLimit(n int) {
+	g.checkNil()
+
+	g.group.SetLimit(n)
+}
+
+// Wait blocks until all function calls from the Go method have returned, then
+// returns the values by key and the errors, like Group.Wait.
+func (g *KeyedGroup[K, V]) Wait() (map[K]V, error) {
+	g.checkNil()
+
+	results, err := g.group.Wait()
+
+	values := make(map[K]V, len(results))
+	for _, r := range results {
+		values[r.key] = r.value
+	}
+
+	return values, err
+}
+
+// checkNil panics with a clear message if g is nil.
+func (g *KeyedGroup[K, V]) checkNil() {
+	if g == nil {
+		panic(errNilKeyedGroup)
+	}
+}
@@ -0,0 +1,109 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testKeyedGroupResults)
+	t.Run("duplicate key", testKeyedGroupDuplicateKey)
+	t.Run("limit", testKeyedGroupLimit)
+	t.Run("nil", testKeyedGroupNil)
+}
+
+// testKeyedGroupResults checks that values are returned by key, and failed keys are left out.
+func testKeyedGroupResults(t *testing.T) {
+	t.Parallel()
+	group, _ := WithKeyedErrorsThreshold[string, int](context.Background(), 2)
+
+	group.Go("one", func() (int, error) {
+		return 1, nil
+	})
+
+	group.Go("two", func() (int, error) {
+		return 2, nil
+	})
+
+	group.Go("three", func() (int, error) {
+		return 3, err1
+	})
+
+	values, err := group.Wait()
+
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.Equal(t, map[string]int{"one": 1, "two": 2}, values, "Expected values to be: %v, got: %v", map[string]int{"one": 1, "two": 2}, values)
+}
+
+// testKeyedGroupDuplicateKey checks that a duplicate key is reported as an error and not run.
+func testKeyedGroupDuplicateKey(t *testing.T) {
+	t.Parallel()
+	group := KeyedGroup[int, string]{}
+
+	group.Go(1, func() (string, error) {
+		return "first", nil
+	})
+
+	group.Go(1, func() (string, error) {
+		return "second", nil
+	})
+
+	values, err := group.Wait()
+
+	assert.True(t, errors.Is(err, ErrDuplicateKey), "Expected error to be: %v, got: %v", ErrDuplicateKey, err)
+	assert.Equal(t, map[int]string{1: "first"}, values, "Expected values to be: %v, got: %v", map[int]string{1: "first"}, values)
+}
+
+// testKeyedGroupLimit checks that the limit bounds the number of tasks running at once.
+func testKeyedGroupLimit(t *testing.T) {
+	t.Parallel()
+	group := KeyedGroup[int, int]{}
+	group.SetLimit(2)
+
+	var running, peak int32
+	for i := 0; i < 10; i++ {
+		i := i
+		group.Go(i, func() (int, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return i * i, nil
+		})
+	}
+
+	values, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, values, 10, "Expected 10 values, got: %v", values)
+	assert.LessOrEqual(t, peak, int32(2), "Expected at most 2 running tasks, got: %v", peak)
+}
+
+// testKeyedGroupNil checks that methods called on a nil KeyedGroup panic with a clear message.
+func testKeyedGroupNil(t *testing.T) {
+	t.Parallel()
+
+	var group *KeyedGroup[string, int]
+
+	methods := map[string]func(){
+		"Go":       func() { group.Go("one", func() (int, error) { return 1, nil }) },
+		"SetLimit": func() { group.SetLimit(1) },
+		"Wait":     func() { _, _ = group.Wait() },
+	}
+
+	for name, method := range methods {
+		assert.PanicsWithValue(t, errNilKeyedGroup, method, "Expected %s to panic with: %s", name, errNilKeyedGroup)
+	}
+}
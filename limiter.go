@@ -0,0 +1,39 @@
+package resultgroup
+
+import "context"
+
+// Limiter throttles the start of tasks. It is satisfied by *rate.Limiter
+// from golang.org/x/time/rate, so a Group can be bound to a number of
+// requests per second.
+type Limiter interface {
+	// Wait blocks until a task may start, or returns an error if ctx is
+	// canceled first or the task can never start.
+	Wait(ctx context.Context) error
+}
+
+// SetRateLimit makes each task wait for limiter, with the group context,
+// before its function is called. Unlike SetLimit, it does not block Go:
+// the tasks wait in their own goroutines. If limiter returns an error, the
+// function is not called and the error is recorded as the error of the
+// task. A nil limiter, the default, disables throttling.
+func (g *Group[T]) SetRateLimit(limiter Limiter) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.limiter = limiter
+}
+
+// throttle waits for the limiter set with SetRateLimit, if any.
+func (g *Group[T]) throttle() error {
+	g.mutex.Lock()
+	limiter := g.limiter
+	g.mutex.Unlock()
+
+	if limiter == nil {
+		return nil
+	}
+
+	return limiter.Wait(g.context())
+}
@@ -0,0 +1,78 @@
+package resultgroup
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tickLimiter lets a task start on every tick, like a rate.Limiter without burst.
+type tickLimiter struct {
+	ticks <-chan time.Time
+	waits atomic.Int32
+}
+
+func (l *tickLimiter) Wait(ctx context.Context) error {
+	l.waits.Add(1)
+	select {
+	case <-l.ticks:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("throttled", testRateLimitThrottled)
+	t.Run("canceled", testRateLimitCanceled)
+}
+
+// testRateLimitThrottled checks that every task waits for the limiter before running.
+func testRateLimitThrottled(t *testing.T) {
+	t.Parallel()
+	ticker := time.NewTicker(5 * time.Millisecond)
+	defer ticker.Stop()
+
+	limiter := &tickLimiter{ticks: ticker.C}
+	group := Group[int]{}
+	group.SetRateLimit(limiter)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			return []int{i}, nil
+		})
+	}
+
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{0, 1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{0, 1, 2, 3}, results)
+	assert.Equal(t, int32(4), limiter.waits.Load(), "Expected every task to wait, got: %v", limiter.waits.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond, "Expected the tasks to be spread over 4 ticks")
+}
+
+// testRateLimitCanceled checks that the limiter error is recorded without running the function.
+func testRateLimitCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetRateLimit(&tickLimiter{})
+	group.Cancel()
+
+	var ran atomic.Bool
+	group.Go(func() ([]int, error) {
+		ran.Store(true)
+		return []int{1}, nil
+	})
+
+	_, err := group.Wait()
+
+	assert.False(t, ran.Load(), "Expected the function not to run")
+	assert.ErrorIs(t, err, context.Canceled, "Expected error to be: %v, got: %v", context.Canceled, err)
+}
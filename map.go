@@ -0,0 +1,130 @@
+package resultgroup
+
+import "context"
+
+// Map runs f for every input concurrently, and returns the concatenated
+// results and errors, like a Group created with WithErrorsThreshold.
+// Once the threshold is reached or ctx is canceled, the remaining inputs
+// are skipped; if ctx is canceled, its error is joined with the returned
+// errors.
+// Threshold must be greater than or equal to 1.
+func Map[In, Out any](ctx context.Context, threshold int, inputs []In, f func(In) ([]Out, error)) ([]Out, error) {
+	return MapLimit(ctx, threshold, -1, inputs, f)
+}
+
+// MapLimit works like Map, but runs at most limit calls of f at the same
+// time. A negative limit indicates no limit.
+func MapLimit[In, Out any](ctx context.Context, threshold, limit int, inputs []In, f func(In) ([]Out, error)) ([]Out, error) {
+	group, groupCtx := WithErrorsThreshold[Out](ctx, threshold)
+	group.SetLimit(limit)
+
+	var skipped error
+
+	for _, in := range inputs {
+		if groupCtx.Err() != nil {
+			skipped = ctx.Err()
+			break
+		}
+
+		in := in
+		group.Go(func() ([]Out, error) {
+			return f(in)
+		})
+	}
+
+	return group.WaitWith(skipped)
+}
+
+// MapEach runs f for every input concurrently, at most limit at the same
+// time, and returns one output per input, in the order of the inputs.
+// Like a Group created with WithFailFast, the first error cancels the
+// context passed to f, skips the remaining inputs, and is returned as is;
+// the outputs of the inputs that failed or were skipped are left as zero
+// values. If ctx is canceled, its error is joined with the returned error.
+// A negative limit indicates no limit.
+func MapEach[In, Out any](ctx context.Context, inputs []In, limit int, f func(context.Context, In) (Out, error)) ([]Out, error) {
+	outputs := make([]Out, len(inputs))
+	err := forEachIndex(ctx, len(inputs), limit, func(ctx context.Context, i int) error {
+		out, err := f(ctx, inputs[i])
+		if err != nil {
+			return err
+		}
+
+		outputs[i] = out
+		return nil
+	})
+
+	return outputs, err
+}
+
+// ForEach runs f for every input concurrently, at most limit at the same
+// time, and returns the first error, like MapEach for functions without
+// outputs.
+func ForEach[In any](ctx context.Context, inputs []In, limit int, f func(context.Context, In) error) error {
+	return forEachIndex(ctx, len(inputs), limit, func(ctx context.Context, i int) error {
+		return f(ctx, inputs[i])
+	})
+}
+
+// forEachIndex runs f for every index below n in a fail-fast Group.
+func forEachIndex(ctx context.Context, n, limit int, f func(context.Context, int) error) error {
+	group, groupCtx := WithFailFast[struct{}](ctx)
+	group.SetLimit(limit)
+
+	var skipped error
+
+	for i := 0; i < n; i++ {
+		if groupCtx.Err() != nil {
+			skipped = ctx.Err()
+			break
+		}
+
+		i := i
+		group.Go(func() ([]struct{}, error) {
+			return nil, f(groupCtx, i)
+		})
+	}
+
+	_, err := group.WaitWith(skipped)
+	return err
+}
+
+// MapChunks splits inputs into chunks of chunkSize, the last one possibly
+// shorter, and runs f for every chunk concurrently, at most limit at the
+// same time, for bulk or paginated downstream calls. The results are
+// concatenated in the order of the chunks. Like MapEach, the first error
+// cancels the context passed to f, skips the remaining chunks, and is
+// returned as is, along with the results of the chunks that succeeded.
+// The chunks share the backing array of inputs, but appending to a chunk
+// does not overwrite the next one. A negative limit indicates no limit.
+// ChunkSize must be greater than or equal to 1.
+func MapChunks[In, Out any](ctx context.Context, inputs []In, chunkSize, limit int, f func(context.Context, []In) ([]Out, error)) ([]Out, error) {
+	if chunkSize < 1 {
+		panic("chunk size must be greater than or equal to 1")
+	}
+
+	group, groupCtx := WithFailFast[Out](ctx)
+	group.SetLimit(limit)
+	group.SetOrderedResults(true)
+
+	var skipped error
+
+	for from := 0; from < len(inputs); from += chunkSize {
+		if groupCtx.Err() != nil {
+			skipped = ctx.Err()
+			break
+		}
+
+		to := from + chunkSize
+		if to > len(inputs) {
+			to = len(inputs)
+		}
+
+		chunk := inputs[from:to:to]
+		group.Go(func() ([]Out, error) {
+			return f(groupCtx, chunk)
+		})
+	}
+
+	return group.WaitWith(skipped)
+}
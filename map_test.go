@@ -0,0 +1,162 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testMapResults)
+	t.Run("threshold", testMapThreshold)
+	t.Run("canceled", testMapCanceled)
+}
+
+func TestMapEach(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ordered outputs", testMapEachOrdered)
+	t.Run("first error", testMapEachFirstError)
+	t.Run("for each", testForEach)
+}
+
+func TestMapChunks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testMapChunksResults)
+	t.Run("first error", testMapChunksFirstError)
+}
+
+// testMapResults checks that Map returns the results for all inputs.
+func testMapResults(t *testing.T) {
+	t.Parallel()
+
+	results, err := Map(context.Background(), 1, []int{1, 2, 3}, func(i int) ([]string, error) {
+		return []string{strconv.Itoa(i)}, nil
+	})
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, results, "Expected results to be: %v, got: %v", []string{"1", "2", "3"}, results)
+}
+
+// testMapThreshold checks that MapLimit skips the remaining inputs once the threshold is reached.
+func testMapThreshold(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	results, err := MapLimit(context.Background(), 1, 1, []int{1, 2, 3, 4}, func(i int) ([]int, error) {
+		calls++
+		if i == 2 {
+			return nil, err1
+		}
+
+		return []int{i}, nil
+	})
+
+	assert.Equal(t, err1, unwrapErrors(err)[0], "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.LessOrEqual(t, calls, 3, "Expected the remaining inputs to be skipped, got %d calls", calls)
+}
+
+// testMapCanceled checks that Map skips the inputs and reports the error of a canceled context.
+func testMapCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := Map(ctx, 1, []int{1, 2}, func(i int) ([]int, error) {
+		return []int{i}, nil
+	})
+
+	assert.True(t, errors.Is(err, context.Canceled), "Expected error to be: %v, got: %v", context.Canceled, err)
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+}
+
+// testMapEachOrdered checks that the outputs follow the order of the inputs.
+func testMapEachOrdered(t *testing.T) {
+	t.Parallel()
+	inputs := []int{5, 4, 3, 2, 1}
+
+	outputs, err := MapEach(context.Background(), inputs, 2, func(ctx context.Context, in int) (string, error) {
+		time.Sleep(time.Duration(in) * time.Millisecond)
+		return strconv.Itoa(in * 10), nil
+	})
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []string{"50", "40", "30", "20", "10"}, outputs, "Expected outputs in input order, got: %v", outputs)
+}
+
+// testMapEachFirstError checks that the first error is returned as is and cancels the context of the other calls.
+func testMapEachFirstError(t *testing.T) {
+	t.Parallel()
+
+	outputs, err := MapEach(context.Background(), []int{1, 2}, -1, func(ctx context.Context, in int) (int, error) {
+		if in == 1 {
+			return 0, err1
+		}
+
+		<-ctx.Done()
+		return in, ctx.Err()
+	})
+
+	assert.Equal(t, err1, err, "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{0, 0}, outputs, "Expected zero outputs for failed inputs, got: %v", outputs)
+}
+
+// testForEach checks that ForEach calls f for every input.
+func testForEach(t *testing.T) {
+	t.Parallel()
+	var sum atomic.Int64
+
+	err := ForEach(context.Background(), []int{1, 2, 3}, 1, func(ctx context.Context, in int) error {
+		sum.Add(int64(in))
+		return nil
+	})
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, int64(6), sum.Load(), "Expected sum to be: 6, got: %v", sum.Load())
+}
+
+// testMapChunksResults checks that the inputs are split into chunks and the results follow the order of the chunks.
+func testMapChunksResults(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	results, err := MapChunks(context.Background(), []int{1, 2, 3, 4, 5}, 2, 2, func(ctx context.Context, chunk []int) ([]int, error) {
+		calls.Add(1)
+		sum := 0
+		for _, i := range chunk {
+			sum += i
+		}
+
+		return []int{sum}, nil
+	})
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{3, 7, 5}, results, "Expected results to be: %v, got: %v", []int{3, 7, 5}, results)
+	assert.Equal(t, int32(3), calls.Load(), "Expected 3 chunks, got: %v", calls.Load())
+}
+
+// testMapChunksFirstError checks that the first error is returned as is and cancels the other chunks.
+func testMapChunksFirstError(t *testing.T) {
+	t.Parallel()
+
+	_, err := MapChunks(context.Background(), []int{1, 2, 3, 4}, 1, 1, func(ctx context.Context, chunk []int) ([]int, error) {
+		if chunk[0] == 2 {
+			return nil, err1
+		}
+
+		return chunk, ctx.Err()
+	})
+
+	assert.Equal(t, err1, err, "Expected error to be: %v, got: %v", err1, err)
+}
@@ -0,0 +1,136 @@
+package resultgroup
+
+import "context"
+
+// variant holds a single result of a Group3, of one of its three types.
+type variant[A, B, C any] struct {
+	kind uint8
+	a    A
+	b    B
+	c    C
+}
+
+// Group3 is like Group, but its tasks contribute results of three different
+// types, collected into separate slices, under one shared context,
+// threshold and Wait. Use it instead of coordinating the cancellation of
+// several groups by hand. A zero Group3 is valid, like a zero Group.
+type Group3[A, B, C any] struct {
+	group Group[variant[A, B, C]]
+}
+
+// WithErrorsThreshold3 creates a new Group3 with the provided context and a
+// threshold for the maximum number of errors, like WithErrorsThreshold.
+// Threshold must be greater than or equal to 1.
+func WithErrorsThreshold3[A, B, C any](ctx context.Context, threshold int) (group Group3[A, B, C], groupCtx context.Context) {
+	groupCtx = group.group.initThreshold(ctx, threshold)
+	return
+}
+
+// GoA runs the provided function in a new goroutine, like Group.Go, and
+// collects its results into the first slice returned by Wait.
+func (g *Group3[A, B, C]) GoA(f func() ([]A, error)) {
+	g.group.Go(func() ([]variant[A, B, C], error) {
+		res, err := f()
+		return wrapVariants(res, func(v A) variant[A, B, C] { return variant[A, B, C]{kind: 0, a: v} }), err
+	})
+}
+
+// GoB works like GoA, but collects the results into the second slice.
+func (g *Group3[A, B, C]) GoB(f func() ([]B, error)) {
+	g.group.Go(func() ([]variant[A, B, C], error) {
+		res, err := f()
+		return wrapVariants(res, func(v B) variant[A, B, C] { return variant[A, B, C]{kind: 1, b: v} }), err
+	})
+}
+
+// GoC works like GoA, but collects the results into the third slice.
+func (g *Group3[A, B, C]) GoC(f func() ([]C, error)) {
+	g.group.Go(func() ([]variant[A, B, C], error) {
+		res, err := f()
+		return wrapVariants(res, func(v C) variant[A, B, C] { return variant[A, B, C]{kind: 2, c: v} }), err
+	})
+}
+
+// SetLimit limits the number of tasks running at once, like Group.SetLimit.
+// The limit is shared by the tasks of every type.
+func (g *Group3[A, B, C]) SetLimit(n int) {
+	g.group.SetLimit(n)
+}
+
+// Wait blocks until all function calls from the Go methods have returned,
+// then returns the results of each type and the errors, like Group.Wait.
+func (g *Group3[A, B, C]) Wait() ([]A, []B, []C, error) {
+	res, err := g.group.Wait()
+
+	var (
+		as []A
+		bs []B
+		cs []C
+	)
+
+	for _, v := range res {
+		switch v.kind {
+		case 0:
+			as = append(as, v.a)
+		case 1:
+			bs = append(bs, v.b)
+		default:
+			cs = append(cs, v.c)
+		}
+	}
+
+	return as, bs, cs, err
+}
+
+// Group2 is like Group3, for tasks contributing results of two different
+// types. A zero Group2 is valid, like a zero Group.
+type Group2[A, B any] struct {
+	group Group3[A, B, struct{}]
+}
+
+// WithErrorsThreshold2 creates a new Group2 with the provided context and a
+// threshold for the maximum number of errors, like WithErrorsThreshold.
+// Threshold must be greater than or equal to 1.
+func WithErrorsThreshold2[A, B any](ctx context.Context, threshold int) (group Group2[A, B], groupCtx context.Context) {
+	groupCtx = group.group.group.initThreshold(ctx, threshold)
+	return
+}
+
+// GoA runs the provided function in a new goroutine, like Group.Go, and
+// collects its results into the first slice returned by Wait.
+func (g *Group2[A, B]) GoA(f func() ([]A, error)) {
+	g.group.GoA(f)
+}
+
+// GoB works like GoA, but collects the results into the second slice.
+func (g *Group2[A, B]) GoB(f func() ([]B, error)) {
+	g.group.GoB(f)
+}
+
+// SetLimit limits the number of tasks running at once, like Group.SetLimit.
+// The limit is shared by the tasks of both types.
+func (g *Group2[A, B]) SetLimit(n int) {
+	g.group.SetLimit(n)
+}
+
+// Wait blocks until all function calls from the Go methods have returned,
+// then returns the results of each type and the errors, like Group.Wait.
+func (g *Group2[A, B]) Wait() ([]A, []B, error) {
+	as, bs, _, err := g.group.Wait()
+
+	return as, bs, err
+}
+
+// wrapVariants converts the results of a task into variants.
+func wrapVariants[T, V any](res []T, wrap func(T) V) []V {
+	if res == nil {
+		return nil
+	}
+
+	vs := make([]V, len(res))
+	for i, v := range res {
+		vs[i] = wrap(v)
+	}
+
+	return vs
+}
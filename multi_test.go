@@ -0,0 +1,68 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGroup2 checks that the results of each type are collected separately, under a shared threshold.
+func TestGroup2(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold2[int, string](context.Background(), 1)
+
+	group.GoA(func() ([]int, error) {
+		return []int{1, 2}, nil
+	})
+
+	group.GoB(func() ([]string, error) {
+		return []string{"a"}, nil
+	})
+
+	ints, strs, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{1, 2}, ints, "Expected ints to be: %v, got: %v", []int{1, 2}, ints)
+	assert.Equal(t, []string{"a"}, strs, "Expected strings to be: %v, got: %v", []string{"a"}, strs)
+
+	group, ctx = WithErrorsThreshold2[int, string](context.Background(), 1)
+
+	group.GoA(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.GoB(func() ([]string, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	_, _, err = group.Wait()
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected error to be: %v, got: %v", err1, err)
+}
+
+// TestGroup3 checks that the results of each type are collected separately.
+func TestGroup3(t *testing.T) {
+	t.Parallel()
+	group := Group3[int, string, bool]{}
+	group.SetLimit(1)
+
+	group.GoC(func() ([]bool, error) {
+		return []bool{true}, nil
+	})
+
+	group.GoA(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.GoB(func() ([]string, error) {
+		return []string{"a", "b"}, nil
+	})
+
+	ints, strs, bools, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{1}, ints, "Expected ints to be: %v, got: %v", []int{1}, ints)
+	assert.Equal(t, []string{"a", "b"}, strs, "Expected strings to be: %v, got: %v", []string{"a", "b"}, strs)
+	assert.Equal(t, []bool{true}, bools, "Expected bools to be: %v, got: %v", []bool{true}, bools)
+}
@@ -0,0 +1,63 @@
+package resultgroup
+
+// OverflowPolicy is what a Group does with the results collected beyond the
+// maximum set with SetMaxResultsPolicy.
+type OverflowPolicy int
+
+const (
+	// OverflowCancel cancels the group context once the maximum is reached
+	// and discards further results, like SetMaxResults.
+	OverflowCancel OverflowPolicy = iota
+	// OverflowDropNewest discards the results beyond the maximum, but lets
+	// the remaining tasks run.
+	OverflowDropNewest
+	// OverflowDropOldest keeps the most recent results: each result beyond
+	// the maximum evicts the oldest collected one. The remaining tasks run.
+	OverflowDropOldest
+)
+
+// SetMaxResultsPolicy works like SetMaxResults, but lets policy decide what
+// happens once max results are collected, to bound the memory used by tasks
+// that may return pathologically large slices without necessarily stopping
+// the group. The budget, quality target and sink set with SetSink see the
+// results before OverflowDropOldest evicts them.
+// With SetOrderedResults, SetShardedResults or Stream, the collected results
+// cannot be evicted, so OverflowDropOldest behaves like OverflowDropNewest.
+// A max of 0 disables the limit.
+func (g *Group[T]) SetMaxResultsPolicy(max int, policy OverflowPolicy) {
+	g.checkNil()
+
+	if max < 0 {
+		panic("max results must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.maxResults = max
+	g.overflow = policy
+}
+
+// keepsNewest reports whether the results beyond the maximum evict the
+// oldest ones. It must be called with the mutex held.
+func (g *Group[T]) keepsNewest() bool {
+	return g.overflow == OverflowDropOldest && !g.ordered && !g.sharded && g.stream == nil
+}
+
+// dropOldest evicts the oldest collected results beyond the maximum, if the
+// overflow policy says so. It must be called with the mutex held.
+func (g *Group[T]) dropOldest() {
+	if g.maxResults == 0 || !g.keepsNewest() || len(g.results) <= g.maxResults {
+		return
+	}
+
+	n := copy(g.results, g.results[len(g.results)-g.maxResults:])
+
+	// Clear the evicted tail so the results it references can be freed.
+	var zero T
+	for i := n; i < len(g.results); i++ {
+		g.results[i] = zero
+	}
+
+	g.results = g.results[:n]
+}
@@ -0,0 +1,68 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxResultsPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cancel", testMaxResultsPolicyCancel)
+	t.Run("drop newest", testMaxResultsPolicyDropNewest)
+	t.Run("drop oldest", testMaxResultsPolicyDropOldest)
+}
+
+// overflowGroup runs two tasks returning 5 results in total, one after the
+// other, in a group keeping at most 3 results with policy. It returns the
+// results and whether the group context was canceled before Wait.
+func overflowGroup(t *testing.T, policy OverflowPolicy) ([]int, bool) {
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetLimit(1)
+	group.SetMaxResultsPolicy(3, policy)
+
+	group.Go(func() ([]int, error) {
+		return []int{1, 2}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{3, 4, 5}, nil
+	})
+
+	_, _ = group.WaitTask(1)
+	canceled := ctx.Err() != nil
+	results, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+
+	return results, canceled
+}
+
+// testMaxResultsPolicyCancel checks that OverflowCancel cancels the context once the maximum is reached.
+func testMaxResultsPolicyCancel(t *testing.T) {
+	t.Parallel()
+	results, canceled := overflowGroup(t, OverflowCancel)
+
+	assert.Equal(t, []int{1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3}, results)
+	assert.True(t, canceled, "Expected the context to be canceled")
+}
+
+// testMaxResultsPolicyDropNewest checks that OverflowDropNewest discards the results beyond the maximum without canceling.
+func testMaxResultsPolicyDropNewest(t *testing.T) {
+	t.Parallel()
+	results, canceled := overflowGroup(t, OverflowDropNewest)
+
+	assert.Equal(t, []int{1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3}, results)
+	assert.False(t, canceled, "Expected the context not to be canceled")
+}
+
+// testMaxResultsPolicyDropOldest checks that OverflowDropOldest keeps the most recent results without canceling.
+func testMaxResultsPolicyDropOldest(t *testing.T) {
+	t.Parallel()
+	results, canceled := overflowGroup(t, OverflowDropOldest)
+
+	assert.Equal(t, []int{3, 4, 5}, results, "Expected results to be: %v, got: %v", []int{3, 4, 5}, results)
+	assert.False(t, canceled, "Expected the context not to be canceled")
+}
@@ -0,0 +1,51 @@
+package resultgroup
+
+import "context"
+
+// Pipe feeds the results of stage, as they are streamed, to f, which runs
+// as a task of the returned group, so chained stages do not collect all the
+// intermediate results in a slice.
+// The returned group shares the context of stage, and the errors of stage
+// are also recorded by it, so its threshold, copied from stage, counts the
+// errors of both stages, and reaching it cancels both. Since tasks of stage
+// block until their results are fed, a limit set with SetLimit on the
+// returned group applies backpressure to stage.
+// Pipe makes stage stream its results, so it must be called before any task
+// of stage is started, and limits on the returned group must be set before
+// as well. Call Wait on stage once all its tasks are submitted, then Wait on
+// the returned group, which returns the results of the pipeline and the
+// errors of both stages. Waiting for stage does not cancel the shared
+// context.
+func Pipe[A, B any](stage *Group[A], f func(context.Context, A) ([]B, error)) *Group[B] {
+	stage.checkNil()
+
+	stream := stage.Stream()
+
+	stage.mutex.Lock()
+	next := &Group[B]{
+		cancel:    stage.cancel,
+		threshold: stage.threshold,
+		parent:    stage.parent,
+		ctx:       stage.ctx,
+		failFast:  stage.failFast,
+	}
+	stage.forward = next.addError
+	stage.noCancelOnWait = true
+	stage.mutex.Unlock()
+
+	// The feeder counts as a task of next, so Wait on next returns once
+	// every result of stage has been fed.
+	next.wg.Add(1)
+	go func() {
+		defer next.wg.Done()
+
+		for a := range stream {
+			a := a
+			next.GoCtx(func(ctx context.Context) ([]B, error) {
+				return f(ctx, a)
+			})
+		}
+	}()
+
+	return next
+}
@@ -0,0 +1,66 @@
+package resultgroup
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testPipeResults)
+	t.Run("shared threshold", testPipeSharedThreshold)
+}
+
+// testPipeResults checks that the results of the first stage are fed to the second one.
+func testPipeResults(t *testing.T) {
+	t.Parallel()
+	stage, _ := WithErrorsThreshold[int](context.Background(), 3)
+	next := Pipe(&stage, func(ctx context.Context, n int) ([]string, error) {
+		if n == 3 {
+			return nil, err2
+		}
+		return []string{strconv.Itoa(n * 10)}, nil
+	})
+
+	stage.Go(func() ([]int, error) {
+		return []int{1, 2}, nil
+	})
+
+	stage.Go(func() ([]int, error) {
+		return []int{3}, err1
+	})
+
+	_, stageErr := stage.Wait()
+	results, err := next.Wait()
+
+	assert.Equal(t, []error{err1}, unwrapErrors(stageErr), "Expected the stage errors to be: %v, got: %v", []error{err1}, stageErr)
+	assert.ElementsMatch(t, []string{"10", "20"}, results, "Expected results to be: %v, got: %v", []string{"10", "20"}, results)
+	assert.ElementsMatch(t, []error{err1, err2}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1, err2}, err)
+}
+
+// testPipeSharedThreshold checks that the errors of both stages reach a single threshold.
+func testPipeSharedThreshold(t *testing.T) {
+	t.Parallel()
+	stage, ctx := WithErrorsThreshold[int](context.Background(), 2)
+	next := Pipe(&stage, func(ctx context.Context, n int) ([]int, error) {
+		return nil, err2
+	})
+
+	stage.Go(func() ([]int, error) {
+		return []int{1}, err1
+	})
+
+	_, _ = stage.Wait()
+
+	assert.Eventually(t, func() bool { return ctx.Err() != nil }, time.Second, time.Millisecond,
+		"Expected the errors of both stages to reach the threshold")
+
+	_, err := next.Wait()
+
+	assert.ElementsMatch(t, []error{err1, err2}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1, err2}, err)
+}
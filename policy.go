@@ -0,0 +1,53 @@
+package resultgroup
+
+import "context"
+
+// Decision is how an error policy handles an error returned by a task.
+type Decision int
+
+const (
+	// ErrorCount collects the error and counts it toward the threshold,
+	// like an error without a policy.
+	ErrorCount Decision = iota
+	// ErrorIgnore drops the error, as if the task had returned no error.
+	ErrorIgnore
+	// ErrorFatal collects the error and cancels the group context right
+	// away, whatever the threshold.
+	ErrorFatal
+)
+
+// WithErrorPolicy creates a new Group with the provided context whose errors
+// are classified by policy, for APIs where some errors are retriable noise
+// and others must stop everything. Without a threshold, only fatal errors
+// cancel the context. Use SetErrorPolicy to combine a policy with a
+// threshold.
+func WithErrorPolicy[T any](ctx context.Context, policy func(err error) Decision) (group Group[T], groupCtx context.Context) {
+	group.policy = policy
+	groupCtx = group.initContext(ctx)
+
+	return
+}
+
+// SetErrorPolicy sets the function that decides, for each error returned by
+// a task, whether it is ignored, counted toward the threshold, or fatal.
+// The policy is called with the group mutex held, so calls are serialized,
+// and it must not call methods of the group. A nil policy counts every
+// error.
+func (g *Group[T]) SetErrorPolicy(policy func(err error) Decision) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.policy = policy
+}
+
+// classify returns the decision of the error policy for err. It must be
+// called with the mutex held.
+func (g *Group[T]) classify(err error) Decision {
+	if err == nil || g.policy == nil {
+		return ErrorCount
+	}
+
+	return g.policy(err)
+}
@@ -0,0 +1,90 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("decisions", testErrorPolicyDecisions)
+	t.Run("threshold", testErrorPolicyThreshold)
+}
+
+// classifyTestErrors ignores err1, treats err3 as fatal, and counts the other errors.
+func classifyTestErrors(err error) Decision {
+	switch {
+	case errors.Is(err, err1):
+		return ErrorIgnore
+	case errors.Is(err, err3):
+		return ErrorFatal
+	default:
+		return ErrorCount
+	}
+}
+
+// testErrorPolicyDecisions checks that ignored errors are dropped, and only fatal errors cancel without a threshold.
+func testErrorPolicyDecisions(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorPolicy[int](context.Background(), classifyTestErrors)
+	group.SetLimit(1)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, _ = group.WaitTask(1)
+	assert.NoError(t, ctx.Err(), "Expected counted errors not to cancel without a threshold, got: %v", ctx.Err())
+
+	group.Go(func() ([]int, error) {
+		return nil, err3
+	})
+
+	_, _ = group.WaitTask(2)
+	assert.Error(t, ctx.Err(), "Expected a fatal error to cancel the context")
+
+	results, err := group.Wait()
+
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+	assert.Equal(t, []error{err2, err3}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err2, err3}, err)
+}
+
+// testErrorPolicyThreshold checks that only counted errors reach the threshold.
+func testErrorPolicyThreshold(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 2)
+	group.SetErrorPolicy(classifyTestErrors)
+	group.SetLimit(1)
+
+	for i := 0; i < 5; i++ {
+		group.Go(func() ([]int, error) {
+			return nil, err1
+		})
+	}
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, _ = group.WaitTask(5)
+	assert.NoError(t, ctx.Err(), "Expected ignored errors not to count, got: %v", ctx.Err())
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, _ = group.WaitTask(6)
+	assert.Error(t, ctx.Err(), "Expected the threshold to be reached")
+
+	_, err := group.Wait()
+
+	assert.Equal(t, []error{err2, err2}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err2, err2}, err)
+}
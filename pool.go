@@ -0,0 +1,158 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+)
+
+// errNilPool is the panic message for methods called on a nil *Pool.
+const errNilPool = "resultgroup: method called on nil *Pool"
+
+// Pool is like Group, but runs the tasks on a fixed set of worker
+// goroutines fed by a bounded queue, instead of starting a goroutine per
+// task, which bounds the scheduler and memory pressure of bursty
+// submissions of many tasks. Errors are collected without a threshold.
+// A Pool must be created with NewPool, and cannot be used after Wait:
+// like with Group, submitting a task once Wait has returned panics.
+type Pool[T any] struct {
+	start   sync.Once
+	stop    sync.Once
+	workers int
+	queue   chan func() ([]T, error)
+	group   Group[T]
+}
+
+// NewPool creates a new Pool with the provided context and number of
+// workers. The queue holds as many pending tasks as there are workers.
+// The workers are started on the first call to Go.
+// Workers must be greater than or equal to 1.
+func NewPool[T any](ctx context.Context, workers int) (pool Pool[T], poolCtx context.Context) {
+	if workers < 1 {
+		panic("workers must be greater than or equal to 1")
+	}
+
+	pool.workers = workers
+	pool.queue = make(chan func() ([]T, error), workers)
+	pool.group.noTaskResults = true
+	poolCtx = pool.group.initContext(ctx)
+
+	return
+}
+
+// Go enqueues the provided function to be run by a worker, and collects its
+// results and error like Group.Go. It blocks while the queue is full; if
+// the pool context is canceled meanwhile, the function is not run.
+func (p *Pool[T]) Go(f func() ([]T, error)) {
+	p.checkNil()
+
+	p.start.Do(func() {
+		for i := 0; i < p.workers; i++ {
+			go p.work()
+		}
+	})
+
+	p.group.mutex.Lock()
+	waited := p.group.waited
+	p.group.mutex.Unlock()
+
+	if waited {
+		panic(errWaited)
+	}
+
+	if p.group.ctx.Err() != nil {
+		return
+	}
+
+	// A queued task counts as pending for Started until it starts.
+	p.group.mutex.Lock()
+	p.group.pending++
+	p.group.mutex.Unlock()
+
+	p.group.wg.Add(1)
+	select {
+	case p.queue <- f:
+	case <-p.group.ctx.Done():
+		p.group.mutex.Lock()
+		p.group.leavePending()
+		p.group.mutex.Unlock()
+		p.group.wg.Done()
+	}
+}
+
+// work runs the queued tasks until the queue is closed. While the pool is
+// paused, the worker does not pull new tasks from the queue.
+func (p *Pool[T]) work() {
+	for {
+		// A canceled pool still dequeues the tasks, to skip them.
+		_ = p.group.waitResumed()
+
+		f, ok := <-p.queue
+		if !ok {
+			return
+		}
+
+		// The task is registered when it is dequeued, so the pool never
+		// waits on tasks that were not accepted. It was already counted
+		// as pending when it was queued.
+		t := p.group.addTask("")
+		p.group.mutex.Lock()
+		p.group.pending--
+		p.group.mutex.Unlock()
+
+		p.group.run(t, f)
+		p.group.done(0)
+	}
+}
+
+// Pause stops the workers from starting new tasks until Resume is called,
+// like Group.Pause. Queued tasks stay in the queue, so Go blocks once it is
+// full.
+func (p *Pool[T]) Pause() {
+	p.checkNil()
+
+	p.group.Pause()
+}
+
+// Resume lets the workers start tasks again after Pause.
+func (p *Pool[T]) Resume() {
+	p.checkNil()
+
+	p.group.Resume()
+}
+
+// Started returns a channel that is closed once every task submitted to the
+// pool so far, including the queued ones, has started running, like
+// Group.Started.
+func (p *Pool[T]) Started() <-chan struct{} {
+	p.checkNil()
+
+	return p.group.Started()
+}
+
+// Cancel cancels the pool context. Queued tasks still run, unless they
+// observe the context.
+func (p *Pool[T]) Cancel() {
+	p.checkNil()
+
+	p.group.Cancel()
+}
+
+// Wait blocks until all enqueued tasks have returned, stops the workers,
+// then returns the results and errors like Group.Wait.
+func (p *Pool[T]) Wait() ([]T, error) {
+	p.checkNil()
+
+	results, err := p.group.Wait()
+	p.stop.Do(func() {
+		close(p.queue)
+	})
+
+	return results, err
+}
+
+// checkNil panics with a clear message if p is nil.
+func (p *Pool[T]) checkNil() {
+	if p == nil {
+		panic(errNilPool)
+	}
+}
@@ -0,0 +1,258 @@
+package resultgroup
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testPoolResults)
+	t.Run("canceled", testPoolCanceled)
+	t.Run("go after wait", testPoolGoAfterWait)
+	t.Run("pause", testPoolPause)
+	t.Run("pause canceled", testPoolPauseCanceled)
+	t.Run("started", testPoolStarted)
+	t.Run("started paused", testPoolStartedPaused)
+	t.Run("task results", testPoolTaskResults)
+	t.Run("nil", testPoolNil)
+}
+
+// testPoolResults checks that a pool runs every task on at most its number of workers.
+func testPoolResults(t *testing.T) {
+	t.Parallel()
+	pool, _ := NewPool[int](context.Background(), 3)
+
+	var running, peak atomic.Int32
+	for i := 0; i < 100; i++ {
+		i := i
+		pool.Go(func() ([]int, error) {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			runtime.Gosched()
+			running.Add(-1)
+
+			if i == 50 {
+				return nil, err1
+			}
+			return []int{i}, nil
+		})
+	}
+
+	results, err := pool.Wait()
+
+	assert.Len(t, results, 99, "Expected 99 results, got: %d", len(results))
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.LessOrEqual(t, peak.Load(), int32(3), "Expected at most 3 running tasks, got: %v", peak.Load())
+}
+
+// testPoolCanceled checks that a canceled pool stops accepting tasks instead of blocking.
+func testPoolCanceled(t *testing.T) {
+	t.Parallel()
+	pool, _ := NewPool[int](context.Background(), 1)
+	release := make(chan struct{})
+
+	pool.Go(func() ([]int, error) {
+		<-release
+		return []int{1}, nil
+	})
+	pool.Go(func() ([]int, error) {
+		return []int{2}, nil
+	})
+
+	pool.Cancel()
+
+	var ran atomic.Bool
+	for i := 0; i < 10; i++ {
+		pool.Go(func() ([]int, error) {
+			ran.Store(true)
+			return nil, nil
+		})
+	}
+
+	close(release)
+	results, err := pool.Wait()
+
+	assert.False(t, ran.Load(), "Expected the tasks submitted after Cancel not to run")
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{1, 2}, results, "Expected results to be: %v, got: %v", []int{1, 2}, results)
+}
+
+// testPoolGoAfterWait checks that submitting a task to a pool after Wait panics, like with a Group.
+func testPoolGoAfterWait(t *testing.T) {
+	t.Parallel()
+	pool, _ := NewPool[int](context.Background(), 1)
+
+	pool.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+	_, _ = pool.Wait()
+
+	assert.PanicsWithValue(t, errWaited, func() {
+		pool.Go(func() ([]int, error) {
+			return nil, nil
+		})
+	}, "Expected Go to panic after Wait")
+}
+
+// testPoolPause checks that the workers of a paused pool do not start the queued tasks until Resume.
+func testPoolPause(t *testing.T) {
+	t.Parallel()
+	pool, _ := NewPool[int](context.Background(), 2)
+	pool.Pause()
+
+	var ran atomic.Int32
+	for i := 0; i < 2; i++ {
+		i := i
+		pool.Go(func() ([]int, error) {
+			ran.Add(1)
+			return []int{i}, nil
+		})
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(0), ran.Load(), "Expected no task to run while paused, got: %d", ran.Load())
+
+	pool.Resume()
+	results, err := pool.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{0, 1}, results, "Expected results to be: %v, got: %v", []int{0, 1}, results)
+}
+
+// testPoolStarted checks that Started waits for the queued tasks of a pool, not only the dequeued ones.
+func testPoolStarted(t *testing.T) {
+	t.Parallel()
+	pool, _ := NewPool[int](context.Background(), 1)
+	release := make(chan struct{})
+
+	pool.Go(func() ([]int, error) {
+		<-release
+		return []int{1}, nil
+	})
+	pool.Go(func() ([]int, error) {
+		return []int{2}, nil
+	})
+
+	started := pool.Started()
+	select {
+	case <-started:
+		t.Fatal("Expected Started not to be closed while a task is queued")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-started
+
+	results, err := pool.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{1, 2}, results, "Expected results to be: %v, got: %v", []int{1, 2}, results)
+}
+
+// testPoolTaskResults checks that a pool does not keep the results of each task besides the collected ones.
+func testPoolTaskResults(t *testing.T) {
+	t.Parallel()
+	pool, _ := NewPool[int](context.Background(), 2)
+
+	for i := 0; i < 3; i++ {
+		pool.Go(func() ([]int, error) {
+			return make([]int, 1000), nil
+		})
+	}
+
+	results, _ := pool.Wait()
+
+	assert.Len(t, results, 3000, "Expected 3000 results, got: %d", len(results))
+	for _, task := range pool.group.tasks {
+		assert.Nil(t, task.res, "Expected the results of task %d not to be kept, got: %d", task.index, len(task.res))
+	}
+}
+
+// testPoolPauseCanceled checks that the tasks held back by a paused pool are skipped with the context error once it is canceled.
+func testPoolPauseCanceled(t *testing.T) {
+	t.Parallel()
+	pool, ctx := NewPool[int](context.Background(), 2)
+	pool.Pause()
+
+	var ran atomic.Bool
+	for i := 0; i < 2; i++ {
+		pool.Go(func() ([]int, error) {
+			ran.Store(true)
+			return []int{1}, nil
+		})
+	}
+
+	pool.Cancel()
+	results, err := pool.Wait()
+
+	assert.False(t, ran.Load(), "Expected the paused tasks not to run once the pool is canceled")
+	assert.NoError(t, err, "Expected the skipped tasks to record no error, got: %v", err)
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+	assert.ErrorIs(t, ctx.Err(), context.Canceled, "Expected the pool context to be canceled, got: %v", ctx.Err())
+}
+
+// testPoolStartedPaused checks that Started is closed once the tasks held back by a paused pool have all started.
+func testPoolStartedPaused(t *testing.T) {
+	t.Parallel()
+	pool, _ := NewPool[int](context.Background(), 2)
+	pool.Pause()
+
+	var running atomic.Int32
+	release := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		pool.Go(func() ([]int, error) {
+			running.Add(1)
+			<-release
+			return []int{1}, nil
+		})
+	}
+
+	started := pool.Started()
+	select {
+	case <-started:
+		t.Fatal("Expected Started not to be closed while the pool is paused")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	pool.Resume()
+	<-started
+	assert.Eventually(t, func() bool { return running.Load() == 2 }, time.Second, time.Millisecond, "Expected both tasks to run once Started is closed")
+
+	close(release)
+	results, err := pool.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Len(t, results, 2, "Expected 2 results, got: %d", len(results))
+}
+
+// testPoolNil checks that methods called on a nil Pool panic with a clear message.
+func testPoolNil(t *testing.T) {
+	t.Parallel()
+
+	var pool *Pool[int]
+
+	methods := map[string]func(){
+		"Go":      func() { pool.Go(func() ([]int, error) { return nil, nil }) },
+		"Pause":   func() { pool.Pause() },
+		"Resume":  func() { pool.Resume() },
+		"Started": func() { _ = pool.Started() },
+		"Cancel":  func() { pool.Cancel() },
+		"Wait":    func() { _, _ = pool.Wait() },
+	}
+
+	for name, method := range methods {
+		assert.PanicsWithValue(t, errNilPool, method, "Expected %s to panic with: %s", name, errNilPool)
+	}
+}
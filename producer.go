@@ -0,0 +1,60 @@
+package resultgroup
+
+import (
+	"context"
+	"runtime/debug"
+)
+
+// GoProducer runs produce in a new goroutine to submit tasks to the group
+// lazily, for inputs that come from a cursor or a stream and should not be
+// loaded into memory upfront. Produce is called with the group context and
+// a submit function that runs the given function as a task, like Go: with a
+// limit set with SetLimit, submit blocks until a slot is free, so the
+// producer only pulls inputs as fast as the tasks complete. Submit reports
+// false if the task was not run because the group context was canceled,
+// and the producer should then stop.
+// The error returned by produce, if any, is recorded like the error of a
+// task, and Wait waits for produce to return.
+func (g *Group[T]) GoProducer(produce func(ctx context.Context, submit func(f func() ([]T, error)) bool) error) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	waited := g.waited
+	if !waited {
+		g.wg.Add(1)
+	}
+	g.mutex.Unlock()
+
+	if waited {
+		panic(errWaited)
+	}
+
+	ctx := g.context()
+	submit := func(f func() ([]T, error)) bool {
+		if ctx.Err() != nil || !g.acquire(1) {
+			return false
+		}
+
+		g.start(1, "", f)
+		return true
+	}
+
+	go func() {
+		defer g.wg.Done()
+
+		if err := runProducer(ctx, produce, submit); err != nil {
+			g.addError(err)
+		}
+	}()
+}
+
+// runProducer calls produce and converts a panic into a PanicError.
+func runProducer[T any](ctx context.Context, produce func(context.Context, func(func() ([]T, error)) bool) error, submit func(func() ([]T, error)) bool) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+
+	return produce(ctx, submit)
+}
@@ -0,0 +1,71 @@
+package resultgroup
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoProducer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("backpressure", testGoProducerBackpressure)
+	t.Run("canceled", testGoProducerCanceled)
+}
+
+// testGoProducerBackpressure checks that the producer submits the tasks as slots free up, and that Wait waits for it.
+func testGoProducerBackpressure(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(2)
+
+	var running, peak atomic.Int32
+	group.GoProducer(func(ctx context.Context, submit func(f func() ([]int, error)) bool) error {
+		for i := 0; i < 10; i++ {
+			i := i
+			submit(func() ([]int, error) {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+
+				return []int{i}, nil
+			})
+		}
+
+		return err1
+	})
+
+	results, err := group.Wait()
+
+	assert.Len(t, results, 10, "Expected a result per input, got: %v", results)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected the producer error, got: %v", err)
+	assert.LessOrEqual(t, peak.Load(), int32(2), "Expected at most 2 running tasks, got: %v", peak.Load())
+}
+
+// testGoProducerCanceled checks that submit reports false once the group context is canceled.
+func testGoProducerCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetLimit(1)
+
+	var submitted atomic.Int32
+	group.GoProducer(func(ctx context.Context, submit func(f func() ([]int, error)) bool) error {
+		for submit(func() ([]int, error) { return nil, err1 }) {
+			submitted.Add(1)
+		}
+
+		return nil
+	})
+
+	_, err := group.Wait()
+
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected error to be: %v, got: %v", err1, err)
+	assert.GreaterOrEqual(t, submitted.Load(), int32(1), "Expected the producer to stop once canceled, got: %v", submitted.Load())
+}
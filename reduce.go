@@ -0,0 +1,84 @@
+package resultgroup
+
+import (
+	"context"
+	"sync"
+)
+
+// errNilReduceGroup is the panic message for methods called on a nil
+// *ReduceGroup.
+const errNilReduceGroup = "resultgroup: method called on nil *ReduceGroup"
+
+// ReduceGroup is like Group, but instead of concatenating the results of
+// the tasks, it folds them into an accumulator as they are collected, so
+// aggregations such as sums, top-k or merges do not need to keep every
+// result in memory.
+type ReduceGroup[T, A any] struct {
+	once   sync.Once
+	acc    A
+	reduce func(acc A, item T) A
+	group  Group[T]
+}
+
+// WithReduceErrorsThreshold creates a new ReduceGroup with the provided
+// context and a threshold for the maximum number of errors, like
+// WithErrorsThreshold. The results are folded into init with reduce, which
+// is called with the group mutex held, so calls are serialized.
+// Threshold must be greater than or equal to 1.
+func WithReduceErrorsThreshold[T, A any](ctx context.Context, threshold int, init A, reduce func(acc A, item T) A) (group ReduceGroup[T, A], groupCtx context.Context) {
+	group.acc, group.reduce = init, reduce
+	groupCtx = group.group.initThreshold(ctx, threshold)
+
+	return
+}
+
+// Go runs the provided function in a new goroutine, and folds its results
+// into the accumulator. Errors are handled like in Group.Go.
+func (g *ReduceGroup[T, A]) Go(f func() ([]T, error)) {
+	g.checkNil()
+
+	g.once.Do(func() {
+		g.group.mutex.Lock()
+		defer g.group.mutex.Unlock()
+
+		g.group.noCollect = true
+		g.group.sink = g.fold
+	})
+
+	g.group.Go(f)
+}
+
+// fold folds the results of a task into the accumulator. It is called with
+// the group mutex held.
+func (g *ReduceGroup[T, A]) fold(res []T) {
+	for _, item := range res {
+		g.acc = g.reduce(g.acc, item)
+	}
+}
+
+// SetLimit limits the number of tasks running at once, like Group.SetLimit.
+func (g *ReduceGroup[T, A]) SetLimit(n int) {
+	g.checkNil()
+
+	g.group.SetLimit(n)
+}
+
+// Wait blocks until all function calls from the Go method have returned, then
+// returns the accumulator and the errors, like Group.Wait.
+func (g *ReduceGroup[T, A]) Wait() (A, error) {
+	g.checkNil()
+
+	_, err := g.group.Wait()
+
+	g.group.mutex.Lock()
+	defer g.group.mutex.Unlock()
+
+	return g.acc, err
+}
+
+// checkNil panics with a clear message if g is nil.
+func (g *ReduceGroup[T, A]) checkNil() {
+	if g == nil {
+		panic(errNilReduceGroup)
+	}
+}
@@ -0,0 +1,75 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduceGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sum", testReduceGroupSum)
+	t.Run("no tasks", testReduceGroupNoTasks)
+	t.Run("nil", testReduceGroupNil)
+}
+
+// testReduceGroupSum checks that results are folded into the accumulator without being kept.
+func testReduceGroupSum(t *testing.T) {
+	t.Parallel()
+	group, _ := WithReduceErrorsThreshold(context.Background(), 2, 0, func(acc, item int) int {
+		return acc + item
+	})
+	group.SetLimit(2)
+
+	for i := 0; i < 100; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			return []int{i, i}, nil
+		})
+	}
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	sum, err := group.Wait()
+
+	assert.Equal(t, 9900, sum, "Expected sum to be: 9900, got: %v", sum)
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.Equal(t, 0, group.group.ResultsLen(), "Expected no results to be kept, got: %v", group.group.ResultsLen())
+	for _, task := range group.group.tasks {
+		assert.Nil(t, task.res, "Expected the results of task %d not to be kept, got: %d", task.index, len(task.res))
+	}
+}
+
+// testReduceGroupNoTasks checks that a group without tasks returns the initial accumulator.
+func testReduceGroupNoTasks(t *testing.T) {
+	t.Parallel()
+	group, _ := WithReduceErrorsThreshold(context.Background(), 1, "init", func(acc string, item int) string {
+		return acc
+	})
+
+	acc, err := group.Wait()
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, "init", acc, "Expected the initial accumulator, got: %v", acc)
+}
+
+// testReduceGroupNil checks that methods called on a nil ReduceGroup panic with a clear message.
+func testReduceGroupNil(t *testing.T) {
+	t.Parallel()
+
+	var group *ReduceGroup[int, int]
+
+	methods := map[string]func(){
+		"Go":       func() { group.Go(func() ([]int, error) { return nil, nil }) },
+		"SetLimit": func() { group.SetLimit(1) },
+		"Wait":     func() { _, _ = group.Wait() },
+	}
+
+	for name, method := range methods {
+		assert.PanicsWithValue(t, errNilReduceGroup, method, "Expected %s to panic with: %s", name, errNilReduceGroup)
+	}
+}
@@ -0,0 +1,106 @@
+package resultgroup
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// errorJSON is the JSON encoding of an error of a MultiError.
+type errorJSON struct {
+	Message  string        `json:"message"`
+	Count    int           `json:"count,omitempty"`
+	Task     *int          `json:"task,omitempty"`
+	Label    string        `json:"label,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, so the errors can be logged as
+// structured fields instead of parsing the message: it encodes the number
+// of errors and, for each error, its message, its number of occurrences if
+// errors are deduplicated, and the index, label and duration of its task
+// when it wraps a *TaskError.
+func (me *MultiError) MarshalJSON() ([]byte, error) {
+	out := struct {
+		Count   int         `json:"count"`
+		Omitted int         `json:"omitted,omitempty"`
+		Errors  []errorJSON `json:"errors"`
+	}{Omitted: me.omitted, Errors: make([]errorJSON, 0, len(me.errs))}
+
+	for i, err := range me.errs {
+		e := errorJSON{Message: err.Error()}
+		if n := me.count(i); n > 1 {
+			e.Count = n
+		}
+
+		var taskErr *TaskError
+		if errors.As(err, &taskErr) {
+			index := taskErr.Index
+			e.Task, e.Label, e.Duration = &index, taskErr.Label, taskErr.Duration
+		}
+
+		out.Count += me.count(i)
+		out.Errors = append(out.Errors, e)
+	}
+
+	return json.Marshal(out)
+}
+
+// Report summarizes the outcome of the tasks of a Group, for structured
+// logging. It can be encoded as JSON as is.
+type Report struct {
+	// Tasks is the number of tasks submitted.
+	Tasks int `json:"tasks"`
+	// Failed is the number of tasks that returned an error other than a
+	// context cancellation, like Stats.Failed. Tasks that were skipped or
+	// canceled are not counted.
+	Failed int `json:"failed"`
+	// Errors is the number of errors returned by the tasks, including the
+	// ones dropped once the threshold was reached.
+	Errors int `json:"errors"`
+	// Outcomes holds the outcome of each task, ordered by submission index.
+	Outcomes []TaskReport `json:"outcomes"`
+}
+
+// TaskReport is the outcome of a task in a Report.
+type TaskReport struct {
+	// Index is the submission index of the task, counting from 0.
+	Index int `json:"index"`
+	// Label is the label given to GoNamed, or empty.
+	Label string `json:"label,omitempty"`
+	// Results is the number of results returned by the task.
+	Results int `json:"results"`
+	// Duration is how long the task function ran.
+	Duration time.Duration `json:"duration"`
+	// Error is the message of the error returned by the task, or empty.
+	Error string `json:"error,omitempty"`
+}
+
+// Report waits for the tasks like WaitDetailed, and returns a summary of
+// their outcomes with the labels, durations and error messages of the
+// tasks, for logging failures as structured fields.
+func (g *Group[T]) Report() Report {
+	g.checkNil()
+
+	outcomes := g.WaitDetailed()
+	report := Report{Tasks: len(outcomes), Errors: g.ErrorCount(), Outcomes: make([]TaskReport, 0, len(outcomes))}
+
+	for _, o := range outcomes {
+		r := TaskReport{Index: o.Index, Label: o.Label, Results: len(o.Results), Duration: o.Duration}
+		if o.Err != nil {
+			r.Error = o.Err.Error()
+		}
+
+		report.Outcomes = append(report.Outcomes, r)
+	}
+
+	g.mutex.Lock()
+	for _, t := range g.tasks {
+		if t.failed {
+			report.Failed++
+		}
+	}
+	g.mutex.Unlock()
+
+	return report
+}
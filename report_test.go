@@ -0,0 +1,102 @@
+package resultgroup
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("marshal errors", testReportMarshalErrors)
+	t.Run("report", testReportTasks)
+	t.Run("skipped tasks", testReportSkipped)
+}
+
+// testReportMarshalErrors checks that the errors are encoded as JSON with the details of their tasks.
+func testReportMarshalErrors(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+
+	group.GoNamed("fetch", func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err2
+	})
+
+	_, err := group.Wait()
+	data, jsonErr := json.Marshal(err)
+
+	assert.NoError(t, jsonErr, "Expected no error, got: %v", jsonErr)
+	assert.JSONEq(t, `{"count":2,"errors":[{"message":"task 0 (fetch): Error 1","task":0,"label":"fetch"},{"message":"Error 2"}]}`, string(removeDurations(t, data)), "Expected the errors as JSON, got: %s", data)
+}
+
+// testReportTasks checks that the report summarizes the outcome of each task.
+func testReportTasks(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+
+	group.Go(func() ([]int, error) {
+		return []int{1, 2}, nil
+	})
+
+	group.GoNamed("fetch", func() ([]int, error) {
+		return nil, err1
+	})
+
+	report := group.Report()
+	for i := range report.Outcomes {
+		report.Outcomes[i].Duration = 0
+	}
+
+	expected := Report{Tasks: 2, Failed: 1, Errors: 1, Outcomes: []TaskReport{
+		{Index: 0, Results: 2},
+		{Index: 1, Label: "fetch", Error: "task 1 (fetch): Error 1"},
+	}}
+	assert.Equal(t, expected, report, "Expected report to be: %v, got: %v", expected, report)
+}
+
+// testReportSkipped checks that the tasks skipped once the context is canceled are not counted as failed.
+func testReportSkipped(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetSkipOnCancel(true)
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+	_, _ = group.WaitTask(0)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	report := group.Report()
+
+	assert.Equal(t, 2, report.Tasks, "Expected 2 tasks, got: %d", report.Tasks)
+	assert.Equal(t, 1, report.Failed, "Expected the skipped task not to count as failed, got: %d", report.Failed)
+	assert.Equal(t, ErrTaskSkipped.Error(), report.Outcomes[1].Error, "Expected the skipped task to report: %v, got: %v", ErrTaskSkipped, report.Outcomes[1].Error)
+}
+
+// removeDurations removes the durations, which vary between runs, from the
+// JSON encoding of a MultiError.
+func removeDurations(t *testing.T, data []byte) []byte {
+	var out map[string]any
+	assert.NoError(t, json.Unmarshal(data, &out), "Expected valid JSON, got: %s", data)
+
+	for _, e := range out["errors"].([]any) {
+		delete(e.(map[string]any), "duration")
+	}
+
+	data, err := json.Marshal(out)
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+
+	return data
+}
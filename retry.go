@@ -0,0 +1,95 @@
+package resultgroup
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy describes how a task started with GoWithRetry is retried.
+type RetryPolicy struct {
+	// Attempts is the maximum number of calls, including the first one.
+	// It must be greater than or equal to 1.
+	Attempts int
+	// Backoff is the wait before the first retry. It doubles before each
+	// following retry.
+	Backoff time.Duration
+	// MaxBackoff caps the wait between retries. 0 means no cap.
+	MaxBackoff time.Duration
+	// Jitter randomizes each wait by up to this fraction of it, in both
+	// directions, so tasks that failed together do not retry in lockstep.
+	// It must be between 0 and 1.
+	Jitter float64
+}
+
+// delay returns the wait before the given retry, counting from 1.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	// Doubling stops before it overflows, which caps the wait without
+	// MaxBackoff.
+	d := p.Backoff
+	for i := 1; i < retry && (p.MaxBackoff == 0 || d < p.MaxBackoff) && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+
+	if p.MaxBackoff != 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 && d > 0 {
+		jittered := float64(d) * (1 + p.Jitter*(2*rand.Float64()-1))
+		if jittered >= math.MaxInt64 {
+			return math.MaxInt64
+		}
+		d = time.Duration(jittered)
+	}
+
+	return d
+}
+
+// GoRetry works like Go, but calls f up to attempts times until it returns
+// no error, waiting backoff before the first retry and doubling the wait
+// before each following one. Only the error of the last attempt is recorded,
+// and only the results of the successful attempt are collected.
+// If the group context is canceled while waiting for a retry, the task stops
+// retrying and records the context error.
+// Attempts must be greater than or equal to 1.
+func (g *Group[T]) GoRetry(attempts int, backoff time.Duration, f func() ([]T, error)) {
+	g.checkNil()
+
+	g.GoWithRetry(RetryPolicy{Attempts: attempts, Backoff: backoff}, f)
+}
+
+// GoWithRetry works like GoRetry, with the retries described by policy.
+func (g *Group[T]) GoWithRetry(policy RetryPolicy, f func() ([]T, error)) {
+	g.checkNil()
+
+	if policy.Attempts < 1 {
+		panic("attempts must be greater than or equal to 1")
+	}
+
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		panic("jitter must be between 0 and 1")
+	}
+
+	ctx := g.context()
+	g.Go(func() ([]T, error) {
+		for attempt := 1; ; attempt++ {
+			res, err := f()
+			if err == nil {
+				return res, nil
+			}
+
+			if attempt == policy.Attempts {
+				return nil, err
+			}
+
+			timer := g.newTimer(policy.delay(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C():
+			}
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoRetry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("succeeds", testGoRetrySucceeds)
+	t.Run("fails", testGoRetryFails)
+	t.Run("canceled", testGoRetryCanceled)
+	t.Run("policy", testGoRetryPolicy)
+	t.Run("uncapped backoff", testGoRetryUncappedBackoff)
+}
+
+// testGoRetrySucceeds checks that only the results of the successful attempt are collected.
+func testGoRetrySucceeds(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	var calls atomic.Int32
+
+	group.GoRetry(3, time.Millisecond, func() ([]int, error) {
+		n := calls.Add(1)
+		if n < 3 {
+			return []int{-1}, err1
+		}
+
+		return []int{int(n)}, nil
+	})
+
+	results, err := group.Wait()
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{3}, results, "Expected results to be: %v, got: %v", []int{3}, results)
+}
+
+// testGoRetryFails checks that only the error of the last attempt is recorded.
+func testGoRetryFails(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	var calls atomic.Int32
+
+	group.GoRetry(2, time.Millisecond, func() ([]int, error) {
+		if calls.Add(1) == 1 {
+			return []int{1}, err1
+		}
+
+		return []int{2}, err2
+	})
+
+	results, err := group.Wait()
+
+	assert.Equal(t, []error{err2}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err2}, err)
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+	assert.Equal(t, int32(2), calls.Load(), "Expected 2 attempts, got: %d", calls.Load())
+}
+
+// testGoRetryCanceled checks that retries stop once the group context is canceled.
+func testGoRetryCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 2)
+
+	var calls atomic.Int32
+
+	group.GoRetry(5, time.Second, func() ([]int, error) {
+		calls.Add(1)
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		group.Cancel()
+		return nil, nil
+	})
+
+	start := time.Now()
+	_, err := group.Wait()
+
+	assert.Less(t, time.Since(start), time.Second, "Expected the retries to stop once the context is canceled")
+	assert.True(t, errors.Is(err, context.Canceled), "Expected error to be: %v, got: %v", context.Canceled, err)
+	assert.Equal(t, int32(1), calls.Load(), "Expected 1 attempt, got: %d", calls.Load())
+}
+
+// testGoRetryUncappedBackoff checks that the backoff without MaxBackoff keeps growing instead of overflowing.
+func testGoRetryUncappedBackoff(t *testing.T) {
+	t.Parallel()
+	policy := RetryPolicy{Attempts: 100, Backoff: time.Second}
+
+	prev := policy.delay(1)
+	for retry := 2; retry <= 100; retry++ {
+		d := policy.delay(retry)
+		assert.GreaterOrEqual(t, d, prev, "Expected retry %d to wait at least %v, got: %v", retry, prev, d)
+		prev = d
+	}
+
+	policy.Jitter = 1
+	for i := 0; i < 100; i++ {
+		d := policy.delay(100)
+		assert.GreaterOrEqual(t, d, time.Duration(0), "Expected a positive backoff with jitter, got: %v", d)
+	}
+}
+
+// testGoRetryPolicy checks that the retry policy caps and randomizes the backoff.
+func testGoRetryPolicy(t *testing.T) {
+	t.Parallel()
+	policy := RetryPolicy{Attempts: 5, Backoff: 10 * time.Millisecond, MaxBackoff: 30 * time.Millisecond}
+
+	assert.Equal(t, 10*time.Millisecond, policy.delay(1), "Expected the initial backoff")
+	assert.Equal(t, 20*time.Millisecond, policy.delay(2), "Expected the backoff to double")
+	assert.Equal(t, 30*time.Millisecond, policy.delay(3), "Expected the backoff to be capped")
+	assert.Equal(t, 30*time.Millisecond, policy.delay(60), "Expected the backoff to stay capped")
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := policy.delay(1)
+		assert.True(t, d >= 5*time.Millisecond && d <= 15*time.Millisecond, "Expected the backoff within the jitter, got: %v", d)
+	}
+
+	group := Group[int]{}
+	var calls atomic.Int32
+
+	group.GoWithRetry(policy, func() ([]int, error) {
+		calls.Add(1)
+		return nil, err1
+	})
+
+	_, err := group.Wait()
+
+	assert.Equal(t, []error{err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1}, err)
+	assert.Equal(t, int32(5), calls.Load(), "Expected 5 attempts, got: %v", calls.Load())
+}
@@ -0,0 +1,157 @@
+package resultgroup
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// semaphore is a weighted semaphore, in the spirit of
+// golang.org/x/sync/semaphore. Waiters are served by decreasing priority,
+// and in FIFO order for the same priority, so a heavy task is not starved
+// by a stream of light ones.
+type semaphore struct {
+	mu      sync.Mutex
+	max     int64
+	size    int64
+	cur     int64
+	waiters list.List
+}
+
+type waiter struct {
+	n        int64
+	priority int
+	ready    chan struct{}
+}
+
+func newSemaphore(n int64) *semaphore {
+	return &semaphore{max: n, size: n}
+}
+
+// fits reports whether n units can be acquired. A waiter heavier than the
+// current size still fits alone once no units are held, as long as it does
+// not exceed the maximum size, so shrinking the semaphore cannot block it
+// forever. It must be called with the mutex held.
+func (s *semaphore) fits(n int64) bool {
+	return s.size-s.cur >= n || s.cur == 0 && n <= s.max
+}
+
+// resize sets the size of the semaphore, which must not exceed its maximum
+// size. Units held beyond a smaller size are not revoked, but no new units
+// are acquired until enough are released.
+func (s *semaphore) resize(n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.size = n
+	s.notify()
+}
+
+// acquire blocks until n units are available, or until ctx is done.
+// A nil ctx is never done.
+func (s *semaphore) acquire(ctx context.Context, n int64) error {
+	return s.acquirePriority(ctx, n, 0)
+}
+
+// acquirePriority works like acquire, but queues the waiter ahead of the
+// waiters with a lower priority.
+func (s *semaphore) acquirePriority(ctx context.Context, n int64, priority int) error {
+	s.mu.Lock()
+	if s.fits(n) && s.waiters.Len() == 0 {
+		s.cur += n
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	w := waiter{n: n, priority: priority, ready: make(chan struct{})}
+	el := s.enqueue(w)
+	s.mu.Unlock()
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-done:
+		s.mu.Lock()
+		select {
+		case <-w.ready:
+			// Acquired after ctx was done: give the units back.
+			s.cur -= n
+			s.notify()
+		default:
+			front := s.waiters.Front() == el
+			s.waiters.Remove(el)
+
+			// Waiters behind the front one may fit now.
+			if front {
+				s.notify()
+			}
+		}
+		s.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// enqueue inserts w after the waiters with the same or a higher priority.
+// It must be called with the mutex held.
+func (s *semaphore) enqueue(w waiter) *list.Element {
+	for el := s.waiters.Back(); el != nil; el = el.Prev() {
+		if el.Value.(waiter).priority >= w.priority {
+			return s.waiters.InsertAfter(w, el)
+		}
+	}
+
+	return s.waiters.PushFront(w)
+}
+
+// tryAcquire acquires n units without blocking, and reports whether it did.
+func (s *semaphore) tryAcquire(n int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fits(n) && s.waiters.Len() == 0 {
+		s.cur += n
+		return true
+	}
+
+	return false
+}
+
+// release releases n units.
+func (s *semaphore) release(n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cur -= n
+	if s.cur < 0 {
+		panic("resultgroup: semaphore released more than held")
+	}
+
+	s.notify()
+}
+
+// notify wakes the waiters that fit, in queue order. It must be called with
+// the mutex held.
+func (s *semaphore) notify() {
+	for {
+		next := s.waiters.Front()
+		if next == nil {
+			return
+		}
+
+		w := next.Value.(waiter)
+		if !s.fits(w.n) {
+			return
+		}
+
+		s.cur += w.n
+		s.waiters.Remove(next)
+		close(w.ready)
+	}
+}
@@ -0,0 +1,77 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSemaphore checks that waiters are served in order and that a canceled waiter does not hold units.
+func TestSemaphore(t *testing.T) {
+	t.Parallel()
+	sem := newSemaphore(2)
+
+	assert.Nil(t, sem.acquire(nil, 2), "Expected the first acquire to succeed")
+	assert.False(t, sem.tryAcquire(1), "Expected tryAcquire to fail while the semaphore is full")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, sem.acquire(ctx, 1), context.DeadlineExceeded, "Expected acquire to fail once the context is done")
+
+	acquired := make(chan struct{})
+	go func() {
+		_ = sem.acquire(nil, 2)
+		close(acquired)
+	}()
+
+	sem.release(2)
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the waiter to acquire the released units")
+	}
+
+	sem.release(2)
+
+	assert.True(t, sem.tryAcquire(2), "Expected tryAcquire to succeed once the units are released")
+	assert.Panics(t, func() { sem.release(3) }, "Expected releasing more than held to panic")
+}
+
+// TestSemaphorePriority checks that waiters with a higher priority are served first, in FIFO order for the same priority.
+func TestSemaphorePriority(t *testing.T) {
+	t.Parallel()
+	sem := newSemaphore(1)
+	sem.mu.Lock()
+	for i, priority := range []int{0, 1, 0, 2, 1} {
+		sem.enqueue(waiter{n: int64(i), priority: priority})
+	}
+
+	var order []int64
+	for el := sem.waiters.Front(); el != nil; el = el.Next() {
+		order = append(order, el.Value.(waiter).n)
+	}
+	sem.mu.Unlock()
+
+	assert.Equal(t, []int64{3, 1, 4, 0, 2}, order, "Expected waiters to be: %v, got: %v", []int64{3, 1, 4, 0, 2}, order)
+}
+
+// TestSemaphoreResize checks that a shrunk semaphore blocks new units until enough are released, and still admits a heavy waiter alone.
+func TestSemaphoreResize(t *testing.T) {
+	t.Parallel()
+	sem := newSemaphore(4)
+
+	assert.True(t, sem.tryAcquire(3), "Expected tryAcquire to succeed")
+	sem.resize(2)
+	assert.False(t, sem.tryAcquire(1), "Expected tryAcquire to fail above the new size")
+
+	sem.release(3)
+	assert.True(t, sem.tryAcquire(3), "Expected a heavy waiter to fit alone")
+	sem.release(3)
+
+	sem.resize(4)
+	assert.True(t, sem.tryAcquire(4), "Expected tryAcquire to succeed once grown")
+}
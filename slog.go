@@ -0,0 +1,53 @@
+//go:build go1.21
+
+package resultgroup
+
+import (
+	"log/slog"
+	"strconv"
+)
+
+// LogValue implements slog.LogValuer. A single error is logged as its
+// message, and several errors are logged as a group with one attribute per
+// error, keyed by its position in Errors, so that
+// slog.Error("batch failed", "err", err) stays readable.
+func (me *MultiError) LogValue() slog.Value {
+	if me == nil || len(me.errs) == 0 {
+		return slog.StringValue("")
+	}
+
+	if len(me.errs) == 1 {
+		return slog.StringValue(me.message(0, me.errs[0]))
+	}
+
+	attrs := make([]slog.Attr, 0, len(me.errs))
+	for i, err := range me.errs {
+		attrs = append(attrs, slog.String(strconv.Itoa(i), me.message(i, err)))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer. The report is logged as a group with
+// the number of tasks, failures and errors, and one group per failed task,
+// keyed by its index, with its label, duration and error message.
+func (r Report) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.Int("tasks", r.Tasks),
+		slog.Int("failed", r.Failed),
+		slog.Int("errors", r.Errors),
+	}
+
+	for _, o := range r.Outcomes {
+		if o.Error == "" {
+			continue
+		}
+
+		task := []slog.Attr{slog.Duration("duration", o.Duration), slog.String("error", o.Error)}
+		if o.Label != "" {
+			task = append([]slog.Attr{slog.String("label", o.Label)}, task...)
+		}
+		attrs = append(attrs, slog.Attr{Key: strconv.Itoa(o.Index), Value: slog.GroupValue(task...)})
+	}
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,72 @@
+//go:build go1.21
+
+package resultgroup
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("group", testLogValueGroup)
+	t.Run("single", testLogValueSingle)
+	t.Run("report", testLogValueReport)
+}
+
+// testLogValueGroup checks that several errors are logged as a group with one attribute per error.
+func testLogValueGroup(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Error("batch failed", "err", Join(err1, err2))
+
+	assert.Equal(t, "level=ERROR msg=\"batch failed\" err.0=\"Error 1\" err.1=\"Error 2\"\n", buf.String(),
+		"Expected a grouped error, got: %v", buf.String())
+}
+
+// testLogValueSingle checks that a single error, and no error, degrade to a plain message.
+func testLogValueSingle(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Error 1", Join(err1).(*MultiError).LogValue().String(), "Expected the message of the error")
+	assert.Equal(t, "", (*MultiError)(nil).LogValue().String(), "Expected an empty value for a nil error")
+
+	group := Group[int]{}
+	group.SetDedupeErrors(true)
+	group.SetLimit(1)
+	for i := 0; i < 3; i++ {
+		group.Go(func() ([]int, error) {
+			return nil, err1
+		})
+	}
+
+	_, err := group.Wait()
+	value := err.(*MultiError).LogValue()
+	assert.Equal(t, "Error 1 (x3)", value.String(), "Expected the occurrence count, got: %v", value)
+}
+
+// testLogValueReport checks that a report is logged with the counts and a group per failed task.
+func testLogValueReport(t *testing.T) {
+	t.Parallel()
+	report := Report{Tasks: 2, Failed: 1, Errors: 1, Outcomes: []TaskReport{
+		{Index: 0, Results: 2},
+		{Index: 1, Label: "fetch", Duration: time.Second, Error: "Error 1"},
+	}}
+
+	value := report.LogValue()
+	assert.Equal(t, "[tasks=2 failed=1 errors=1 1=[label=fetch duration=1s error=Error 1]]", value.String(), "Expected the report fields, got: %v", value)
+}
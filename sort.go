@@ -0,0 +1,108 @@
+package resultgroup
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// WaitSorted works like Wait, but returns the results sorted by less, with
+// equal results kept in the order Wait would return them. With
+// SetShardedResults the results of each task are sorted on their own and
+// then merged, instead of sorting all of them at once. The results returned
+// by later calls to Wait are not affected.
+func (g *Group[T]) WaitSorted(less func(a, b T) bool) ([]T, error) {
+	g.checkNil()
+
+	results, err := g.WaitWith()
+
+	g.mutex.Lock()
+	sharded := g.sharded
+	var runs [][]T
+	if sharded {
+		for _, t := range g.tasks {
+			if len(t.kept) > 0 {
+				runs = append(runs, append([]T(nil), t.kept...))
+			}
+		}
+	}
+	g.mutex.Unlock()
+
+	if !sharded {
+		results = append([]T(nil), results...)
+		sort.SliceStable(results, func(i, j int) bool { return less(results[i], results[j]) })
+
+		return results, err
+	}
+
+	for _, run := range runs {
+		run := run
+		sort.SliceStable(run, func(i, j int) bool { return less(run[i], run[j]) })
+	}
+
+	return mergeRuns(runs, len(results), less), err
+}
+
+// mergeRuns merges the sorted runs, holding n results in total, into one
+// sorted slice. Equal results are taken from the earliest run first.
+func mergeRuns[T any](runs [][]T, n int, less func(a, b T) bool) []T {
+	if n == 0 {
+		return nil
+	}
+
+	h := &runHeap[T]{less: less}
+	for i, run := range runs {
+		h.heads = append(h.heads, runHead[T]{run: i, items: run})
+	}
+	heap.Init(h)
+
+	merged := make([]T, 0, n)
+	for h.Len() > 0 {
+		head := &h.heads[0]
+		merged = append(merged, head.items[0])
+
+		if head.items = head.items[1:]; len(head.items) == 0 {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+	}
+
+	return merged
+}
+
+// runHead holds the results of a run that are not merged yet.
+type runHead[T any] struct {
+	run   int
+	items []T
+}
+
+// runHeap is a heap of runs ordered by their first result, then by run.
+type runHeap[T any] struct {
+	heads []runHead[T]
+	less  func(a, b T) bool
+}
+
+func (h *runHeap[T]) Len() int { return len(h.heads) }
+
+func (h *runHeap[T]) Less(i, j int) bool {
+	a, b := h.heads[i], h.heads[j]
+	if h.less(a.items[0], b.items[0]) {
+		return true
+	}
+	if h.less(b.items[0], a.items[0]) {
+		return false
+	}
+
+	return a.run < b.run
+}
+
+func (h *runHeap[T]) Swap(i, j int) { h.heads[i], h.heads[j] = h.heads[j], h.heads[i] }
+
+func (h *runHeap[T]) Push(x any) { h.heads = append(h.heads, x.(runHead[T])) }
+
+func (h *runHeap[T]) Pop() any {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+
+	return last
+}
@@ -0,0 +1,56 @@
+package resultgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitSorted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testWaitSortedResults)
+	t.Run("sharded", testWaitSortedSharded)
+}
+
+// testWaitSortedResults checks that the results are sorted without changing the results of Wait.
+func testWaitSortedResults(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+
+	group.Go(func() ([]int, error) {
+		return []int{3, 1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{2}, nil
+	})
+
+	results, err := group.WaitSorted(func(a, b int) bool { return a < b })
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3}, results)
+
+	unsorted, _ := group.Wait()
+	assert.Equal(t, []int{3, 1, 2}, unsorted, "Expected Wait to return the unsorted results, got: %v", unsorted)
+}
+
+// testWaitSortedSharded checks that the sorted results of the tasks are merged.
+func testWaitSortedSharded(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetShardedResults(true)
+
+	for _, res := range [][]int{{9, 1, 5}, {4, 8}, {}, {7, 2, 6, 3}} {
+		res := res
+		group.Go(func() ([]int, error) {
+			return res, nil
+		})
+	}
+
+	results, err := group.WaitSorted(func(a, b int) bool { return a < b })
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, results, "Expected results to be sorted, got: %v", results)
+}
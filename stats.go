@@ -0,0 +1,92 @@
+package resultgroup
+
+import (
+	"sort"
+	"time"
+)
+
+// Stats summarizes the tasks of a Group, for logging the performance of
+// batch jobs.
+type Stats struct {
+	// Succeeded is the number of tasks that returned no error.
+	Succeeded int
+	// Failed is the number of tasks that returned an error other than a
+	// context cancellation.
+	Failed int
+	// Canceled is the number of tasks that returned context.Canceled or
+	// context.DeadlineExceeded, or that were not run because the group
+	// context was canceled while they were queued or the circuit breaker
+	// was open.
+	Canceled int
+	// Running is the number of tasks that have not returned yet.
+	Running int
+	// Durations holds how long each task ran, ordered by submission index,
+	// or 0 for the tasks that have not returned or were not run.
+	Durations []time.Duration
+	// Total is the sum of Durations.
+	Total time.Duration
+	// Min, Max, P50 and P99 are computed over the durations of the tasks
+	// that returned, using the nearest-rank method.
+	Min, Max, P50, P99 time.Duration
+	// PeakConcurrency is the highest number of tasks running at the same
+	// time.
+	PeakConcurrency int
+}
+
+// Stats returns statistics about the tasks submitted since the group was
+// created or reset. It can be called while tasks are running, and does not
+// wait for them.
+func (g *Group[T]) Stats() Stats {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	stats := Stats{Durations: make([]time.Duration, len(g.tasks)), PeakConcurrency: g.peak}
+	latencies := make([]time.Duration, 0, len(g.tasks))
+
+	for i, t := range g.tasks {
+		switch {
+		case !t.processed:
+			stats.Running++
+			continue
+		case t.canceled:
+			stats.Canceled++
+		case t.failed:
+			stats.Failed++
+		default:
+			stats.Succeeded++
+		}
+
+		if t.skipped {
+			continue
+		}
+
+		stats.Durations[i] = t.duration
+		stats.Total += t.duration
+		latencies = append(latencies, t.duration)
+	}
+
+	if len(latencies) == 0 {
+		return stats
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	stats.Min = latencies[0]
+	stats.Max = latencies[len(latencies)-1]
+	stats.P50 = percentile(latencies, 50)
+	stats.P99 = percentile(latencies, 99)
+
+	return stats
+}
+
+// percentile returns the p-th percentile of the sorted durations, using the
+// nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,44 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestStats checks that the tasks are counted by outcome and that the statistics cover the task durations.
+func TestStats(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		return nil, context.Canceled
+	})
+
+	_, _ = group.Wait()
+	stats := group.Stats()
+
+	assert.Equal(t, 1, stats.Succeeded, "Expected 1 succeeded task, got: %v", stats.Succeeded)
+	assert.Equal(t, 1, stats.Failed, "Expected 1 failed task, got: %v", stats.Failed)
+	assert.Equal(t, 1, stats.Canceled, "Expected 1 canceled task, got: %v", stats.Canceled)
+	assert.Equal(t, 0, stats.Running, "Expected no running task, got: %v", stats.Running)
+	assert.Len(t, stats.Durations, 3, "Expected a duration per task, got: %v", stats.Durations)
+	assert.GreaterOrEqual(t, stats.Max, 10*time.Millisecond, "Expected the max to be the slow task, got: %v", stats.Max)
+	assert.Equal(t, stats.Max, stats.P99, "Expected p99 to be the max, got: %v", stats.P99)
+	assert.LessOrEqual(t, stats.Min, stats.P50, "Expected min <= p50, got: %v > %v", stats.Min, stats.P50)
+	assert.GreaterOrEqual(t, stats.PeakConcurrency, 1, "Expected a peak concurrency, got: %v", stats.PeakConcurrency)
+
+	group.Reset()
+	assert.Equal(t, Stats{Durations: []time.Duration{}}, group.Stats(), "Expected Reset to clear the statistics")
+}
@@ -0,0 +1,144 @@
+package resultgroup
+
+// SetStreamBuffer sets the buffer size of the channel returned by Stream.
+// The default is 0, an unbuffered channel. It must be called before Stream.
+func (g *Group[T]) SetStreamBuffer(n int) {
+	g.checkNil()
+
+	if n < 0 {
+		panic("stream buffer must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.streamBuffer = n
+}
+
+// Stream returns a channel that delivers the results of each task as soon as
+// it returns, instead of collecting them for Wait. Wait still has to be
+// called once all tasks are submitted: it closes the channel after all tasks
+// have returned, and returns the errors. Since tasks block until their
+// results are received, the channel must be consumed concurrently with Wait.
+// A task blocked on a slow consumer stops sending once the group context is
+// canceled, so it does not leak; on a Group without a context it blocks
+// until its results are received.
+// Streamed results are not kept by the group, neither for Wait nor with each
+// task, so Wait returns no results and WaitTask only returns the errors.
+// Stream must be called before any task is started, and subsequent calls
+// return the same channel.
+func (g *Group[T]) Stream() <-chan T {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.stream == nil {
+		g.stream = make(chan T, g.streamBuffer)
+	}
+
+	return g.stream
+}
+
+// Subscribe returns a channel that delivers the results of each task as
+// soon as they are collected, with a buffer of n results, so that several
+// consumers can observe the results as they arrive, for example one writing
+// them to storage and another updating metrics. Unlike Stream, the results
+// are still collected for Wait, and each call returns a new channel that
+// receives every result. Like Stream, Wait closes the channels after all
+// tasks have returned, and a task blocks until its results are received by
+// every subscriber, or until the group context is canceled, so each channel
+// must be consumed concurrently with Wait.
+// Subscribe must be called before any task is started.
+func (g *Group[T]) Subscribe(n int) <-chan T {
+	g.checkNil()
+
+	if n < 0 {
+		panic("subscription buffer must be greater than or equal to 0")
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	ch := make(chan T, n)
+	g.subscribers = append(g.subscribers, ch)
+
+	return ch
+}
+
+// send sends the results to the stream and the subscribers, if any.
+func (g *Group[T]) send(res []T) {
+	if len(res) == 0 {
+		return
+	}
+
+	g.mutex.Lock()
+	stream, subscribers := g.stream, g.subscribers
+	g.mutex.Unlock()
+
+	if stream != nil && !g.deliver(stream, res) {
+		return
+	}
+
+	for _, ch := range subscribers {
+		if !g.deliver(ch, res) {
+			return
+		}
+	}
+}
+
+// deliver sends the results to ch, and reports whether they were all sent
+// before the group context was canceled.
+func (g *Group[T]) deliver(ch chan T, res []T) bool {
+	for _, r := range res {
+		if g.ctx == nil {
+			ch <- r
+			continue
+		}
+
+		select {
+		case ch <- r:
+		case <-g.ctx.Done():
+			return false
+		}
+	}
+
+	return true
+}
+
+// closeStream closes the stream and the subscribers, if any and not closed
+// by an earlier Wait. If some tasks are still running, they are closed once
+// the tasks have returned.
+func (g *Group[T]) closeStream(complete bool) {
+	g.mutex.Lock()
+	if g.streamClosed {
+		g.mutex.Unlock()
+		return
+	}
+	g.streamClosed = true
+	channels := g.subscribers
+	if g.stream != nil {
+		channels = append([]chan T{g.stream}, channels...)
+	}
+	g.mutex.Unlock()
+
+	if len(channels) == 0 {
+		return
+	}
+
+	closeAll := func() {
+		for _, ch := range channels {
+			close(ch)
+		}
+	}
+
+	if complete {
+		closeAll()
+		return
+	}
+
+	go func() {
+		g.wg.Wait()
+		closeAll()
+	}()
+}
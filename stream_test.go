@@ -0,0 +1,130 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("results", testStreamResults)
+	t.Run("canceled", testStreamCanceled)
+	t.Run("subscribe", testStreamSubscribe)
+}
+
+// testStreamResults checks that results are delivered through the stream as tasks return, without being kept.
+func testStreamResults(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetStreamBuffer(1)
+	stream := group.Stream()
+
+	type outcome struct {
+		results []int
+		err     error
+	}
+
+	wait := make(chan outcome)
+
+	go func() {
+		group.Go(func() ([]int, error) {
+			return []int{1, 2}, nil
+		})
+
+		group.Go(func() ([]int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return []int{3}, err1
+		})
+
+		results, err := group.Wait()
+		wait <- outcome{results, err}
+	}()
+
+	var streamed []int
+	for r := range stream {
+		streamed = append(streamed, r)
+	}
+
+	o := <-wait
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, streamed, "Expected streamed results to be: %v, got: %v", []int{1, 2, 3}, streamed)
+	assert.Equal(t, []error{err1}, unwrapErrors(o.err), "Expected errors to be: %v, got: %v", []error{err1}, o.err)
+	assert.Empty(t, o.results, "Expected no results from Wait, got: %v", o.results)
+	for _, task := range group.tasks {
+		assert.Nil(t, task.res, "Expected the results of task %d not to be kept, got: %d", task.index, len(task.res))
+	}
+}
+
+// testStreamCanceled checks that tasks blocked on a slow consumer return once the context is canceled.
+func testStreamCanceled(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	stream := group.Stream()
+
+	group.Go(func() ([]int, error) {
+		return []int{1, 2, 3}, nil
+	})
+
+	assert.Equal(t, 1, <-stream, "Expected the first result to be streamed")
+
+	group.Go(func() ([]int, error) {
+		return nil, err1
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = group.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Wait to return once the context is canceled")
+	}
+
+	_, open := <-stream
+	assert.False(t, open, "Expected the stream to be closed after Wait")
+}
+
+// testStreamSubscribe checks that every subscriber receives every result, and that Wait still returns them.
+func testStreamSubscribe(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	subs := []<-chan int{group.Subscribe(0), group.Subscribe(4)}
+
+	received := make([][]int, len(subs))
+	done := make(chan struct{})
+	for i, sub := range subs {
+		i, sub := i, sub
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for r := range sub {
+				received[i] = append(received[i], r)
+			}
+		}()
+	}
+
+	for i := 1; i <= 3; i++ {
+		i := i
+		group.Go(func() ([]int, error) {
+			return []int{i}, nil
+		})
+	}
+
+	results, err := group.Wait()
+	_, _ = group.Wait()
+	for range subs {
+		<-done
+	}
+
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+	assert.ElementsMatch(t, []int{1, 2, 3}, results, "Expected results to be: %v, got: %v", []int{1, 2, 3}, results)
+	for i := range subs {
+		assert.ElementsMatch(t, []int{1, 2, 3}, received[i], "Expected subscriber %d to receive every result, got: %v", i, received[i])
+	}
+}
@@ -0,0 +1,28 @@
+package resultgroup
+
+import "context"
+
+// SubGroup creates a child Group of g, for nested fan-outs that share the
+// error budget of g, like SubGroupOf for a child with the same result type.
+func (g *Group[T]) SubGroup() (Group[T], context.Context) {
+	g.checkNil()
+
+	return SubGroupOf[T](g)
+}
+
+// SubGroupOf creates a child Group of parent, whose context is derived from
+// the parent context, so canceling the parent cancels the child. Every error
+// returned by the tasks of the child is also recorded by the parent, where
+// it counts toward the parent threshold, so nested fan-outs share a single
+// error budget. The child has no threshold of its own, and its Wait still
+// returns its errors; a parent task running the child should therefore
+// return only the results of the child, not its error, to avoid recording
+// the errors twice.
+func SubGroupOf[C, P any](parent *Group[P]) (group Group[C], groupCtx context.Context) {
+	parent.checkNil()
+
+	group.forward = parent.addError
+	groupCtx = group.initContext(parent.context())
+
+	return
+}
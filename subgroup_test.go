@@ -0,0 +1,64 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shared threshold", testSubGroupSharedThreshold)
+	t.Run("cancellation", testSubGroupCancellation)
+}
+
+// testSubGroupSharedThreshold checks that the errors of the children count toward the parent threshold.
+func testSubGroupSharedThreshold(t *testing.T) {
+	t.Parallel()
+	parent, parentCtx := WithErrorsThreshold[string](context.Background(), 2)
+
+	for _, region := range []string{"eu", "us"} {
+		region := region
+		parent.Go(func() ([]string, error) {
+			shards, _ := SubGroupOf[int](&parent)
+			shards.Go(func() ([]int, error) {
+				return []int{1}, nil
+			})
+			shards.Go(func() ([]int, error) {
+				return nil, err1
+			})
+
+			results, _ := shards.Wait()
+			if len(results) == 0 {
+				return nil, nil
+			}
+			return []string{region}, nil
+		})
+	}
+
+	_, _ = parent.WaitTask(0)
+	_, _ = parent.WaitTask(1)
+
+	assert.Error(t, parentCtx.Err(), "Expected the shard errors to reach the parent threshold")
+
+	results, err := parent.Wait()
+
+	assert.ElementsMatch(t, []string{"eu", "us"}, results, "Expected results to be: %v, got: %v", []string{"eu", "us"}, results)
+	assert.Equal(t, []error{err1, err1}, unwrapErrors(err), "Expected errors to be: %v, got: %v", []error{err1, err1}, err)
+}
+
+// testSubGroupCancellation checks that canceling the parent cancels the child.
+func testSubGroupCancellation(t *testing.T) {
+	t.Parallel()
+	parent, _ := WithErrorsThreshold[int](context.Background(), 1)
+	child, childCtx := parent.SubGroup()
+
+	parent.Cancel()
+
+	assert.Error(t, childCtx.Err(), "Expected the child context to be canceled")
+
+	_, err := child.Wait()
+	assert.NoError(t, err, "Expected no error, got: %v", err)
+}
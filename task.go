@@ -0,0 +1,201 @@
+package resultgroup
+
+import (
+	"runtime/debug"
+	"time"
+)
+
+// task holds the outcome of a single task, in submission order.
+type task[T any] struct {
+	index int
+	label string
+	stack []byte
+	done  chan struct{}
+	res   []T
+	err   error
+
+	// from and to delimit the results of the task in the collected
+	// results, for ordered results.
+	from, to int
+
+	// kept holds the collected results of the task, for sharded results.
+	kept []T
+
+	// duration is how long the task function ran, set when it returns.
+	duration time.Duration
+
+	// processed is set once the outcome of the task is collected, and
+	// failed, canceled and skipped describe that outcome, for Stats.
+	processed bool
+	failed    bool
+	canceled  bool
+	skipped   bool
+
+	// emitted is set by GoCollect tasks that collected results as they
+	// were emitted, so they are not considered empty.
+	emitted bool
+
+	// cleanup is the function given to GoWithCleanup, called by finish.
+	cleanup func(error)
+}
+
+// run runs f as the given task once the group lets it start, and collects
+// its outcome.
+func (g *Group[T]) run(t *task[T], f func() ([]T, error)) {
+	if err := g.waitResumed(); err != nil {
+		g.skip(t, err)
+		return
+	}
+
+	if err := g.skipped(); err != nil {
+		g.skip(t, err)
+		return
+	}
+
+	g.markStarted(t)
+
+	var res []T
+	err := g.throttle()
+	if err == nil {
+		end := g.instrumentTask(t)
+		start := g.now()
+		res, err = call(g.wrapped(f))
+		t.duration = g.now().Sub(start)
+		end(t.duration, err)
+	}
+
+	// Only tasks submitted with rich errors enabled have a stack.
+	if err != nil && (t.stack != nil || t.label != "") {
+		err = &TaskError{
+			Index:    t.index,
+			Label:    t.label,
+			Stack:    t.stack,
+			Duration: t.duration,
+			Err:      err,
+		}
+	}
+
+	// The results are not kept with the task if nothing reads them back.
+	if !g.processResult(t, res, err) {
+		res = nil
+	}
+	t.finish(res, err)
+}
+
+// skipped returns the error reported for a task that must not run, because
+// the circuit breaker is open or the group context is canceled with
+// SetSkipOnCancel, or nil if it can run.
+func (g *Group[T]) skipped() error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	switch {
+	case g.circuitOpen:
+		return ErrCircuitOpen
+	case g.skipOnCancel && g.ctx != nil && g.ctx.Err() != nil:
+		return ErrTaskSkipped
+	}
+
+	return nil
+}
+
+// call calls f and converts a panic into a PanicError. Whether f returned is
+// tracked apart from the recovered value, which is nil for panic(nil) when
+// the module runs with the panicnil setting of Go versions before 1.21.
+func call[T any](f func() ([]T, error)) (res []T, err error) {
+	completed := false
+	defer func() {
+		if v := recover(); !completed {
+			res, err = nil, PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+
+	res, err = f()
+	completed = true
+
+	return res, err
+}
+
+// finish stores the outcome of the task, calls its cleanup function, if
+// any, and marks it as done.
+func (t *task[T]) finish(res []T, err error) {
+	t.res = res
+	t.err = err
+	if t.cleanup != nil {
+		t.cleanup(err)
+	}
+	close(t.done)
+}
+
+// WaitTask blocks until the task submitted at the given index, counting from
+// 0 in the order of the Go calls, has returned, and returns its own results
+// and error. The other tasks keep running. It is useful when a particular
+// task is a critical dependency of the next stage.
+// If the results are discarded with SetDiscardResults, passed to a sink set
+// with SetSink, as in a ReduceGroup, or streamed with Stream, WaitTask only
+// returns the error of the task.
+// WaitTask panics if no task was submitted at index.
+func (g *Group[T]) WaitTask(index int) ([]T, error) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	if index < 0 || index >= len(g.tasks) {
+		g.mutex.Unlock()
+		panic("resultgroup: task index out of range")
+	}
+
+	t := g.tasks[index]
+	g.mutex.Unlock()
+
+	<-t.done
+
+	return t.res, t.err
+}
+
+// TaskOutcome holds the outcome of a single task, as returned by
+// WaitDetailed.
+type TaskOutcome[T any] struct {
+	// Index is the submission index of the task, counting from 0.
+	Index int
+	// Label is the label given to GoNamed, or empty.
+	Label string
+	// Results are the results returned by the task.
+	Results []T
+	// Err is the error returned by the task, a PanicError if it panicked,
+	// or the error of the group context if Wait stopped waiting for it.
+	Err error
+	// Duration is how long the task function ran, or 0 if Wait stopped
+	// waiting for it.
+	Duration time.Duration
+}
+
+// WaitDetailed works like Wait, but returns the outcome of each task, ordered
+// by submission index, instead of the aggregated results and errors, so
+// failures can be correlated back to their inputs, for example for audits.
+// The results and errors are still collected by the group as usual, so the
+// threshold and the other options apply.
+// Tasks that were not waited for, because the grace period set with
+// SetCancelGrace expired, get the error of the group context.
+func (g *Group[T]) WaitDetailed() []TaskOutcome[T] {
+	g.checkNil()
+
+	_, _ = g.WaitWith()
+
+	g.mutex.Lock()
+	tasks := g.tasks
+	g.mutex.Unlock()
+
+	outcomes := make([]TaskOutcome[T], 0, len(tasks))
+	for _, t := range tasks {
+		outcome := TaskOutcome[T]{Index: t.index, Label: t.label}
+		select {
+		case <-t.done:
+			outcome.Results, outcome.Err, outcome.Duration = t.res, t.err, t.duration
+		default:
+			outcome.Err = g.context().Err()
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes
+}
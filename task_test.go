@@ -0,0 +1,205 @@
+package resultgroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWaitTask checks that WaitTask returns the outcome of a single task while the others keep running.
+func TestWaitTask(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	release := make(chan struct{})
+
+	group.Go(func() ([]int, error) {
+		<-release
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{2}, err1
+	})
+
+	res, err := group.WaitTask(1)
+
+	assert.Equal(t, err1, err, "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, []int{2}, res, "Expected results to be: %v, got: %v", []int{2}, res)
+
+	select {
+	case <-group.tasks[0].done:
+		t.Fatal("Expected the first task to be still running")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+
+	res, err = group.WaitTask(0)
+
+	assert.Nil(t, err, "Expected no error, got: %v", err)
+	assert.Equal(t, []int{1}, res, "Expected results to be: %v, got: %v", []int{1}, res)
+	assert.Panics(t, func() { _, _ = group.WaitTask(2) }, "Expected an out of range index to panic")
+
+	results, _ := group.Wait()
+
+	assert.ElementsMatch(t, []int{1, 2}, results, "Expected results to be: %v, got: %v", []int{1, 2}, results)
+}
+
+// TestRichErrors checks that task errors are wrapped in a TaskError with the task details.
+func TestRichErrors(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetRichErrors(true)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, err1
+	})
+
+	results, err := group.Wait()
+
+	var taskErr *TaskError
+
+	assert.True(t, errors.As(err, &taskErr), "Expected error to be a TaskError, got: %v", err)
+	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, 1, taskErr.Index, "Expected index to be 1, got: %d", taskErr.Index)
+	assert.Contains(t, string(taskErr.Stack), "TestRichErrors", "Expected stack to contain the submitting function")
+	assert.GreaterOrEqual(t, taskErr.Duration, 10*time.Millisecond, "Expected duration to be at least 10ms, got: %v", taskErr.Duration)
+	assert.Equal(t, "task 1: Error 1", taskErr.Error(), "Expected a concise error message, got: %s", taskErr.Error())
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// TestGoNamed checks that the errors of labeled tasks are wrapped in a TaskError with the label.
+func TestGoNamed(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+
+	group.GoNamed("fetch-user-41", func() ([]int, error) {
+		return []int{41}, nil
+	})
+
+	group.GoNamed("fetch-user-42", func() ([]int, error) {
+		return nil, err1
+	})
+
+	results, err := group.Wait()
+
+	var taskErr *TaskError
+
+	assert.True(t, errors.As(err, &taskErr), "Expected error to be a TaskError, got: %v", err)
+	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
+	assert.Equal(t, "fetch-user-42", taskErr.Label, "Expected label to be fetch-user-42, got: %s", taskErr.Label)
+	assert.Nil(t, taskErr.Stack, "Expected no stack without rich errors")
+	assert.Equal(t, "task 1 (fetch-user-42): Error 1", taskErr.Error(), "Expected the label in the message, got: %s", taskErr.Error())
+	assert.Equal(t, []int{41}, results, "Expected results to be: %v, got: %v", []int{41}, results)
+}
+
+// TestPanicRecovery checks that a panicking task is recorded as a PanicError instead of crashing.
+func TestPanicRecovery(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	group.SetLimit(1)
+
+	group.Go(func() ([]int, error) {
+		panic("boom")
+	})
+
+	group.Go(func() ([]int, error) {
+		panic(err1)
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	results, err := group.Wait()
+
+	var panicErr PanicError
+
+	assert.True(t, errors.As(err, &panicErr), "Expected error to be a PanicError, got: %v", err)
+	assert.Equal(t, "boom", panicErr.Value, "Expected panic value to be: boom, got: %v", panicErr.Value)
+	assert.Contains(t, string(panicErr.Stack), "TestPanicRecovery", "Expected stack to contain the panicking function")
+	assert.True(t, errors.Is(err, err1), "Expected error to be: %v, got: %v", err1, err)
+	assert.Len(t, unwrapErrors(err), 2, "Expected 2 errors, got: %d", len(unwrapErrors(err)))
+	assert.Equal(t, []int{1}, results, "Expected results to be: %v, got: %v", []int{1}, results)
+}
+
+// TestPanicNil checks that a task panicking with a nil value is recorded as a PanicError instead of a success.
+func TestPanicNil(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+
+	group.Go(func() ([]int, error) {
+		panic(nil)
+	})
+
+	results, err := group.Wait()
+
+	var panicErr PanicError
+
+	assert.True(t, errors.As(err, &panicErr), "Expected error to be a PanicError, got: %v", err)
+	assert.Empty(t, results, "Expected no results, got: %v", results)
+}
+
+// TestWaitDetailed checks that WaitDetailed returns the outcome of each task in submission order.
+func TestWaitDetailed(t *testing.T) {
+	t.Parallel()
+	group := Group[int]{}
+	release := make(chan struct{})
+
+	group.GoNamed("slow", func() ([]int, error) {
+		<-release
+		time.Sleep(10 * time.Millisecond)
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		return []int{2}, err1
+	})
+
+	group.Go(func() ([]int, error) {
+		panic("boom")
+	})
+
+	_, _ = group.WaitTask(2)
+	close(release)
+
+	outcomes := group.WaitDetailed()
+
+	assert.Len(t, outcomes, 3, "Expected 3 outcomes, got: %d", len(outcomes))
+	assert.GreaterOrEqual(t, outcomes[0].Duration, 10*time.Millisecond, "Expected duration to be at least 10ms, got: %v", outcomes[0].Duration)
+	outcomes[0].Duration, outcomes[1].Duration = 0, 0
+	assert.Equal(t, TaskOutcome[int]{Index: 0, Label: "slow", Results: []int{1}}, outcomes[0], "Expected the outcome of the first task, got: %v", outcomes[0])
+	assert.Equal(t, TaskOutcome[int]{Index: 1, Results: []int{2}, Err: err1}, outcomes[1], "Expected the outcome of the second task, got: %v", outcomes[1])
+
+	var panicErr PanicError
+	assert.Equal(t, 2, outcomes[2].Index, "Expected index to be: 2, got: %v", outcomes[2].Index)
+	assert.True(t, errors.As(outcomes[2].Err, &panicErr), "Expected error to be a PanicError, got: %v", outcomes[2].Err)
+}
+
+// TestPanicThreshold checks that recovered panics count toward the threshold and cancel the context.
+func TestPanicThreshold(t *testing.T) {
+	t.Parallel()
+	group, ctx := WithErrorsThreshold[int](context.Background(), 1)
+
+	group.Go(func() ([]int, error) {
+		panic("boom")
+	})
+
+	_, _ = group.WaitTask(0)
+
+	assert.Error(t, ctx.Err(), "Expected the context to be canceled by the panic")
+
+	_, err := group.Wait()
+
+	var panicErr PanicError
+	assert.True(t, errors.As(err, &panicErr), "Expected error to be a PanicError, got: %v", err)
+}
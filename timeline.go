@@ -0,0 +1,84 @@
+package resultgroup
+
+import "time"
+
+// TimelineEvent is the kind of an entry in a group timeline.
+type TimelineEvent int
+
+const (
+	// EventResults is recorded when a task's results are collected.
+	EventResults TimelineEvent = iota + 1
+	// EventError is recorded when an error is added to the group errors.
+	EventError
+	// EventThresholdReached is recorded when the error threshold is reached.
+	EventThresholdReached
+	// EventCanceled is recorded when the group context is canceled.
+	EventCanceled
+)
+
+// String returns the name of the event.
+func (e TimelineEvent) String() string {
+	switch e {
+	case EventResults:
+		return "results"
+	case EventError:
+		return "error"
+	case EventThresholdReached:
+		return "threshold reached"
+	case EventCanceled:
+		return "canceled"
+	default:
+		return "unknown"
+	}
+}
+
+// TimelineEntry is a single event recorded by a group with the timeline
+// enabled. Results is set for EventResults, and Err for EventError.
+type TimelineEntry[T any] struct {
+	Time    time.Time
+	Event   TimelineEvent
+	Results []T
+	Err     error
+}
+
+// SetTimeline enables or disables recording a timeline of the group events,
+// which can be retrieved with WaitTimeline. It is disabled by default to
+// avoid the overhead in normal runs, and is meant for debugging how a batch
+// unfolded.
+func (g *Group[T]) SetTimeline(enabled bool) {
+	g.checkNil()
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.timed = enabled
+}
+
+// WaitTimeline blocks until all function calls from the Go method have
+// returned, then returns the recorded events in chronological order.
+// It does not cancel the group context, so it can be called before or after
+// Wait. The timeline is empty unless it was enabled with SetTimeline.
+func (g *Group[T]) WaitTimeline() []TimelineEntry[T] {
+	g.checkNil()
+
+	g.wg.Wait()
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return append([]TimelineEntry[T](nil), g.timeline...)
+}
+
+// record appends an event to the timeline, if it is enabled. It must be
+// called with the mutex held.
+func (g *Group[T]) record(event TimelineEvent, res []T, err error) {
+	if !g.timed {
+		return
+	}
+
+	g.timeline = append(g.timeline, TimelineEntry[T]{
+		Time:    g.clockLocked().Now(),
+		Event:   event,
+		Results: res,
+		Err:     err,
+	})
+}
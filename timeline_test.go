@@ -0,0 +1,46 @@
+package resultgroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTimeline checks that the Group records its events in chronological order.
+func TestTimeline(t *testing.T) {
+	t.Parallel()
+	group, _ := WithErrorsThreshold[int](context.Background(), 1)
+	group.SetTimeline(true)
+
+	group.Go(func() ([]int, error) {
+		return []int{1}, nil
+	})
+
+	group.Go(func() ([]int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, err1
+	})
+
+	timeline := group.WaitTimeline()
+
+	events := make([]TimelineEvent, 0, len(timeline))
+	for i, entry := range timeline {
+		events = append(events, entry.Event)
+
+		if i > 0 {
+			assert.False(t, entry.Time.Before(timeline[i-1].Time), "Expected entry %d not to be before the previous one", i)
+		}
+	}
+
+	expected := []TimelineEvent{EventResults, EventError, EventThresholdReached, EventCanceled}
+	assert.Equal(t, expected, events, "Expected events to be: %v, got: %v", expected, events)
+	assert.Equal(t, []int{1}, timeline[0].Results, "Expected results to be: %v, got: %v", []int{1}, timeline[0].Results)
+	assert.Equal(t, err1, timeline[1].Err, "Expected error to be: %v, got: %v", err1, timeline[1].Err)
+
+	_, err := group.Wait()
+
+	assert.Equal(t, err1, unwrapErrors(err)[0], "Expected error to be: %v, got: %v", err1, err)
+	assert.Len(t, group.WaitTimeline(), 4, "Expected no new events after Wait, got: %d", len(group.WaitTimeline()))
+}